@@ -23,4 +23,5 @@ type FeegrantKeeper interface {
 type SwingsetKeeper interface {
 	InboundQueueLength(ctx sdk.Context) (int32, error)
 	GetState(ctx sdk.Context) swingtypes.State
+	GetParams(ctx sdk.Context) swingtypes.Params
 }
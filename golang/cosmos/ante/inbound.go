@@ -29,14 +29,15 @@ we don't know how many messages will be allowed at DeliverTx time,
 nor the size of the inbound queue from preceding Txs in the block.
 To mitigate this, x/swingset implements an hysteresis by computing
 the number of messages allowed for mempool admission as if its max
-queue length was lower (e.g. 50%). This is the QueueInboundMempool
+queue length was lower (by default 50%, per the Swingset
+InboundMempoolQueueRatio param). This is the QueueInboundMempool
 entry in the Swingset state QueueAllowed field. At DeliverTx time
 the QueueInbound entry gives the number of allowed messages.
-*/
 
-const (
-	maxInboundPerTx = 1
-)
+Independently of the queue size, a single Tx may add at most the number of
+messages given by the Swingset MaxInboundPerTx param, which allows batch
+messages such as MsgWalletActionBatch to be admitted atomically.
+*/
 
 // TODO: We don't have a more appropriate error type for this.
 var ErrInboundQueueFull = sdkerrors.ErrMempoolIsFull
@@ -122,6 +123,7 @@ func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
 		return 0, nil
 	}
 	allowed -= actions
+	maxInboundPerTx := ia.sk.GetParams(ctx).MaxInboundPerTx
 	if allowed > maxInboundPerTx {
 		return maxInboundPerTx, nil
 	}
@@ -24,6 +24,7 @@ func TestInboundAnteHandle(t *testing.T) {
 		inboundQueueLengthErr error
 		inboundLimit          int32
 		mempoolLimit          int32
+		maxInboundPerTx       int32
 		errMsg                string
 		isHighPriorityOwner   bool
 	}{
@@ -102,6 +103,35 @@ func TestInboundAnteHandle(t *testing.T) {
 			inboundQueueLength: 5,
 			errMsg:             ErrInboundQueueFull.Error(),
 		},
+		{
+			name:               "batch-over-max-per-tx",
+			tx:                 makeTestTx(&swingtypes.MsgWalletActionBatch{Actions: []string{"1", "2"}}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:               "batch-within-max-per-tx",
+			tx:                 makeTestTx(&swingtypes.MsgWalletActionBatch{Actions: []string{"1", "2"}}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			maxInboundPerTx:    2,
+		},
+		{
+			name:               "batch-over-allowed",
+			tx:                 makeTestTx(&swingtypes.MsgWalletActionBatch{Actions: []string{"1", "2", "3"}}),
+			inboundLimit:       10,
+			inboundQueueLength: 8,
+			maxInboundPerTx:    5,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:               "multi-within-max-per-tx",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletActionBatch{Actions: []string{"1", "2"}}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			maxInboundPerTx:    3,
+		},
 		{
 			name:                "priority-limit-bypass",
 			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
@@ -137,6 +167,7 @@ func TestInboundAnteHandle(t *testing.T) {
 				inboundQueueLengthErr: tt.inboundQueueLengthErr,
 				inboundLimit:          tt.inboundLimit,
 				mempoolLimit:          tt.mempoolLimit,
+				maxInboundPerTx:       tt.maxInboundPerTx,
 				emptyQueueAllowed:     emptyQueueAllowed,
 				isHighPriorityOwner:   tt.isHighPriorityOwner,
 			}
@@ -183,6 +214,7 @@ type mockSwingsetKeeper struct {
 	inboundQueueLengthErr error
 	inboundLimit          int32
 	mempoolLimit          int32
+	maxInboundPerTx       int32
 	emptyQueueAllowed     bool
 	isHighPriorityOwner   bool
 }
@@ -206,6 +238,14 @@ func (msk mockSwingsetKeeper) GetState(ctx sdk.Context) swingtypes.State {
 	}
 }
 
+func (msk mockSwingsetKeeper) GetParams(ctx sdk.Context) swingtypes.Params {
+	params := swingtypes.DefaultParams()
+	if msk.maxInboundPerTx != 0 {
+		params.MaxInboundPerTx = msk.maxInboundPerTx
+	}
+	return params
+}
+
 func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
 	return msk.isHighPriorityOwner, nil
 }
@@ -136,6 +136,18 @@ const appName = "agoric"
 // TODO: document this flag in config, likely alongside the genesis path
 const FlagSwingStoreExportDir = "swing-store-export-dir"
 
+// FlagSwingStoreArtifactsMode defines the config flag used to specify how
+// the swing-store artifacts are provided in a genesis export: in the
+// swing-store export directory ("directory", the default), referenced by
+// content hash and path in that directory ("reference"), or embedded in the
+// genesis ("inline").
+const FlagSwingStoreArtifactsMode = "swing-store-artifacts-mode"
+
+// FlagSwingStoreInlineArtifactsMaxSize defines the config flag used to specify
+// the maximum total size in bytes of the swing-store artifacts embedded in a
+// genesis export in "inline" mode.
+const FlagSwingStoreInlineArtifactsMaxSize = "swing-store-inline-artifacts-max-size"
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -159,6 +171,7 @@ var (
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 			swingsetclient.CoreEvalProposalHandler,
+			swingsetclient.HighPrioritySendersProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -510,7 +523,9 @@ func NewAgoricApp(
 
 	app.VbankKeeper = vbank.NewKeeper(
 		appCodec, keys[vbank.StoreKey], app.GetSubspace(vbank.ModuleName),
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+		&app.TransferKeeper, // Not yet initialized, but only used after startup.
+		authtypes.FeeCollectorName,
 		app.SwingSetKeeper.PushAction,
 	)
 	vbankModule := vbank.NewAppModule(app.VbankKeeper)
@@ -596,6 +611,11 @@ func NewAgoricApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	swingStoreExportDir := cast.ToString(appOpts.Get(FlagSwingStoreExportDir))
+	swingStoreArtifactsMode := cast.ToString(appOpts.Get(FlagSwingStoreArtifactsMode))
+	swingStoreInlineArtifactsMaxSize := cast.ToUint64(appOpts.Get(FlagSwingStoreInlineArtifactsMaxSize))
+	if swingStoreInlineArtifactsMaxSize == 0 {
+		swingStoreInlineArtifactsMaxSize = swingset.DefaultSwingStoreInlineArtifactsMaxSize
+	}
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -628,7 +648,7 @@ func NewAgoricApp(
 		transferModule,
 		icaModule,
 		vstorage.NewAppModule(app.VstorageKeeper),
-		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir),
+		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir, swingStoreArtifactsMode, swingStoreInlineArtifactsMaxSize),
 		vibcModule,
 		vbankModule,
 		lienModule,
@@ -1026,7 +1046,7 @@ func (app *GaiaApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 
 // Commit tells the controller that the block is commited
 func (app *GaiaApp) Commit() abci.ResponseCommit {
-	err := swingsetkeeper.WaitUntilSwingStoreExportStarted()
+	err := app.SwingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 
 	if err != nil {
 		app.Logger().Error("swing-store export failed to start", "err", err)
@@ -1161,6 +1181,9 @@ func (app *GaiaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the local status endpoint of swingset state-sync snapshots.
+	apiSvr.Router.HandleFunc("/agoric/swingset/snapshot_progress", app.SwingSetSnapshotter.SnapshotProgressHandler).Methods("GET")
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(apiSvr.Router)
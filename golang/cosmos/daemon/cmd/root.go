@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -27,6 +28,7 @@ import (
 	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -37,6 +39,8 @@ import (
 
 	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 )
 
 // Sender is a function that sends a request to the controller.
@@ -130,7 +134,7 @@ func initRootCmd(sender Sender, rootCmd *cobra.Command, encodingConfig params.En
 		genutilcli.InitCmd(gaia.ModuleBasics, gaia.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, gaia.DefaultNodeHome),
 		genutilcli.GenTxCmd(gaia.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, gaia.DefaultNodeHome),
-		genutilcli.ValidateGenesisCmd(gaia.ModuleBasics),
+		extendValidateGenesisCommand(genutilcli.ValidateGenesisCmd(gaia.ModuleBasics)),
 		AddGenesisAccountCmd(encodingConfig.Marshaler, gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
@@ -150,6 +154,7 @@ func initRootCmd(sender Sender, rootCmd *cobra.Command, encodingConfig params.En
 			break
 		}
 	}
+	rootCmd.AddCommand(ac.swingStoreCommands(gaia.DefaultNodeHome)...)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -335,6 +340,16 @@ const (
 func extendCosmosExportCommand(cmd *cobra.Command) {
 	addAgoricVMFlags(cmd)
 	cmd.Flags().String(FlagExportDir, "", "The directory where to create the genesis export")
+	cmd.Flags().String(
+		gaia.FlagSwingStoreArtifactsMode,
+		swingset.SwingStoreArtifactsModeDirectory,
+		"How to provide the swing-store artifacts: \"directory\" (described by the manifest of the swing-store directory), \"reference\" (listed in the genesis by content hash and path in the swing-store directory) or \"inline\" (embedded in the genesis, for small artifact sets)",
+	)
+	cmd.Flags().Uint64(
+		gaia.FlagSwingStoreInlineArtifactsMaxSize,
+		swingset.DefaultSwingStoreInlineArtifactsMaxSize,
+		"The maximum total size in bytes of the swing-store artifacts embedded in the genesis in \"inline\" mode",
+	)
 	err := cmd.MarkFlagRequired(FlagExportDir)
 	if err != nil {
 		panic(err)
@@ -345,6 +360,11 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 	extendedRunE := func(cmd *cobra.Command, args []string) error {
 		serverCtx := server.GetServerContextFromCmd(cmd)
 
+		artifactsMode, _ := cmd.Flags().GetString(gaia.FlagSwingStoreArtifactsMode)
+		if !swingset.IsValidSwingStoreArtifactsMode(artifactsMode) {
+			return fmt.Errorf("invalid %s value %q", gaia.FlagSwingStoreArtifactsMode, artifactsMode)
+		}
+
 		exportDir, _ := cmd.Flags().GetString(FlagExportDir)
 		err := os.MkdirAll(exportDir, os.ModePerm)
 		if err != nil {
@@ -388,6 +408,61 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 	cmd.RunE = extendedRunE
 }
 
+// extendValidateGenesisCommand monkey-patches the "validate-genesis" command
+// added by cosmos-sdk to also check that the swing-store artifacts referenced
+// by the genesis are present in the swing-store export directory, which by
+// default is the "swing-store" directory sibling to the genesis file.
+func extendValidateGenesisCommand(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(
+		gaia.FlagSwingStoreExportDir,
+		"",
+		"The directory containing the swing-store artifacts (default \"swing-store\" sibling to the genesis file)",
+	)
+
+	originalRunE := cmd.RunE
+
+	extendedRunE := func(cmd *cobra.Command, args []string) error {
+		err := originalRunE(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		clientCtx := client.GetClientContextFromCmd(cmd)
+
+		genesisPath := serverCtx.Config.GenesisFile()
+		if len(args) == 1 {
+			genesisPath = args[0]
+		}
+		swingStoreExportDir, _ := cmd.Flags().GetString(gaia.FlagSwingStoreExportDir)
+		if swingStoreExportDir == "" {
+			swingStoreExportDir = filepath.Join(filepath.Dir(genesisPath), ExportedSwingStoreDirectoryName)
+		}
+
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(genesisPath)
+		if err != nil {
+			return err
+		}
+		swingsetGenesis, ok := appState[swingset.ModuleName]
+		if !ok {
+			return nil
+		}
+		var genesisState swingsettypes.GenesisState
+		err = clientCtx.Codec.UnmarshalJSON(swingsetGenesis, &genesisState)
+		if err != nil {
+			return err
+		}
+		err = swingset.ValidateGenesisSwingStoreArtifactFiles(&genesisState, swingStoreExportDir)
+		if err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesisPath, err)
+		}
+		return nil
+	}
+
+	cmd.RunE = extendedRunE
+	return cmd
+}
+
 func (ac appCreator) appExport(
 	logger log.Logger,
 	db dbm.DB,
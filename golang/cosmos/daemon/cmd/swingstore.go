@@ -0,0 +1,437 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+)
+
+const (
+	// FlagArtifactMode is the command-line flag selecting the set of swing-store
+	// artifacts to export or restore. See the SwingStoreArtifactMode* consts.
+	FlagArtifactMode = "artifact-mode"
+	// FlagExportDataMode is the command-line flag selecting how the swing-store
+	// "export data" is handled. See the SwingStoreExportDataMode* consts.
+	FlagExportDataMode = "export-data-mode"
+	// FlagArchive is the command-line flag specifying a swing-store export
+	// archive file, as an alternative to an export directory.
+	FlagArchive = "archive"
+	// FlagLayout is the command-line flag selecting how the artifact files of a
+	// swing-store export are named. See the SwingStoreExportLayout* consts.
+	FlagLayout = "layout"
+	// FlagBase is the command-line flag specifying the directory or archive of
+	// the base swing-store export of a differential export.
+	FlagBase = "base"
+	// FlagExportHeight is the command-line flag selecting the export by block
+	// height in a content-addressed export directory holding several exports.
+	FlagExportHeight = "export-height"
+)
+
+// swingStoreCommands returns the commands allowing an operator to export the
+// swing-store of a node to a directory, and to restore such an export.
+func (ac appCreator) swingStoreCommands(defaultNodeHome string) []*cobra.Command {
+	return []*cobra.Command{
+		ac.exportSwingStoreCmd(defaultNodeHome),
+		ac.importSwingStoreCmd(defaultNodeHome),
+		ac.verifySwingStoreExportCmd(defaultNodeHome),
+	}
+}
+
+// exportSwingStoreCmd creates the "export-swingstore" command, which writes an
+// export of the swing-store at the latest committed height to a directory.
+func (ac appCreator) exportSwingStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-swingstore",
+		Short: "Export the swing-store at the latest height to a directory or archive",
+		Long: `Export the swing-store at the latest committed height to a directory, or to a
+single .tar.zst archive. The export contains a manifest, the selected artifacts,
+and optionally the swing-store "export data". The node must not be running.
+
+With the content-addressed layout, artifact files are named after the hash of
+their content, and a directory containing a previous export can be reused to
+only write the artifacts that changed since. The manifest of each export is kept
+in the "manifests" sub-directory, and previous exports can be selected by
+height with --export-height when importing or verifying.
+
+With --base, a differential export is created which only contains the artifacts
+that are new or changed since the given base export, and a list of the base
+artifacts that were removed. A differential export must be imported together
+with its base.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			exportOptions := swingsetkeeper.SwingStoreExportOptions{}
+			exportOptions.ArtifactMode, _ = cmd.Flags().GetString(FlagArtifactMode)
+			exportOptions.ExportDataMode, _ = cmd.Flags().GetString(FlagExportDataMode)
+			if err := swingsetkeeper.ValidateSwingStoreExportOptions(exportOptions); err != nil {
+				return err
+			}
+
+			layout, _ := cmd.Flags().GetString(FlagLayout)
+			exportDir, archivePath, err := getSwingStoreExportLocation(cmd)
+			if err != nil {
+				return err
+			}
+
+			var writeExport func(provider swingsetkeeper.SwingStoreExportProvider) error
+			if archivePath != "" {
+				if _, err := os.Stat(archivePath); err == nil {
+					return fmt.Errorf("archive %s already exists", archivePath)
+				}
+				writeExport = func(provider swingsetkeeper.SwingStoreExportProvider) error {
+					archiveFile, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+					if err != nil {
+						return err
+					}
+					defer archiveFile.Close()
+					err = swingsetkeeper.WriteSwingStoreExportToArchive(provider, archiveFile, layout)
+					if err != nil {
+						return err
+					}
+					return archiveFile.Sync()
+				}
+			} else {
+				// A content-addressed export directory can be reused for incremental exports
+				if layout != swingsetkeeper.SwingStoreExportLayoutContentAddressed {
+					if err := ensureEmptyDirectory(exportDir); err != nil {
+						return err
+					}
+				} else if err := os.MkdirAll(exportDir, os.ModePerm); err != nil {
+					return err
+				}
+				writeExport = func(provider swingsetkeeper.SwingStoreExportProvider) error {
+					return swingsetkeeper.WriteSwingStoreExportToDirectoryWithLayout(provider, exportDir, layout)
+				}
+			}
+
+			app, closeApp, err := ac.newSwingStoreApp(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer closeApp()
+
+			if basePath, _ := cmd.Flags().GetString(FlagBase); basePath != "" {
+				baseProvider, _, closeBase, err := openSwingStoreExportPath(basePath)
+				if err != nil {
+					return err
+				}
+				exportOptions.Base, err = swingsetkeeper.ReadSwingStoreExportBase(baseProvider)
+				closeBase()
+				if err != nil {
+					return err
+				}
+			}
+
+			blockHeight := uint64(app.LastBlockHeight())
+			operation, err := app.SwingStoreExportsHandler.QueueExport(
+				blockHeight,
+				swingStoreExportEventHandler{blockHeight: blockHeight, writeExport: writeExport},
+				exportOptions,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = operation.WaitUntilDone()
+			if err != nil {
+				return err
+			}
+
+			location := exportDir
+			if archivePath != "" {
+				location = archivePath
+			}
+			cmd.Printf("exported swing-store at height %d to %s\n", blockHeight, location)
+			return nil
+		},
+	}
+
+	addAgoricVMFlags(cmd)
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagExportDir, "", "The directory where to create the swing-store export")
+	cmd.Flags().String(FlagArchive, "", "The .tar.zst archive file where to create the swing-store export")
+	cmd.Flags().String(FlagArtifactMode, swingsetkeeper.SwingStoreArtifactModeOperational, "The set of artifacts to export (none, operational, replay, archival, debug)")
+	cmd.Flags().String(FlagExportDataMode, swingsetkeeper.SwingStoreExportDataModeAll, "Whether to include the export data (skip, all)")
+	cmd.Flags().String(FlagLayout, swingsetkeeper.SwingStoreExportLayoutFlat, "How to name the artifact files (flat, content-addressed)")
+	cmd.Flags().String(FlagBase, "", "The directory or archive of a base export, to only export the artifacts changed since")
+
+	return cmd
+}
+
+// importSwingStoreCmd creates the "import-swingstore" command, which restores
+// a swing-store export created by "export-swingstore" into the node home.
+func (ac appCreator) importSwingStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-swingstore",
+		Short: "Restore a swing-store export from a directory or archive",
+		Long: `Restore a swing-store export created by export-swingstore into the node home.
+Restoring all the export data requires a fresh swing-store, while repairing
+metadata requires an existing one. The node must not be running.
+
+A differential export must be restored with --base set to the directory or
+archive of the export it was created against.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			restoreOptions := swingsetkeeper.SwingStoreRestoreOptions{}
+			restoreOptions.ArtifactMode, _ = cmd.Flags().GetString(FlagArtifactMode)
+			restoreOptions.ExportDataMode, _ = cmd.Flags().GetString(FlagExportDataMode)
+			if err := swingsetkeeper.ValidateSwingStoreRestoreOptions(restoreOptions); err != nil {
+				return err
+			}
+
+			// Validate the location before launching the VM
+			if _, _, err := getSwingStoreExportLocation(cmd); err != nil {
+				return err
+			}
+
+			app, closeApp, err := ac.newSwingStoreApp(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer closeApp()
+
+			provider, location, closeExport, err := openSwingStoreExport(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeExport()
+
+			if basePath, _ := cmd.Flags().GetString(FlagBase); basePath != "" {
+				baseProvider, _, closeBase, err := openSwingStoreExportPath(basePath)
+				if err != nil {
+					return err
+				}
+				defer closeBase()
+				provider = swingsetkeeper.MergeSwingStoreExports(baseProvider, provider)
+			}
+
+			err = app.SwingStoreExportsHandler.RestoreExport(provider, restoreOptions)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("restored swing-store at height %d from %s\n", provider.BlockHeight, location)
+			return nil
+		},
+	}
+
+	addAgoricVMFlags(cmd)
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagExportDir, "", "The directory containing the swing-store export")
+	cmd.Flags().String(FlagArchive, "", "The .tar.zst archive file containing the swing-store export")
+	cmd.Flags().String(FlagArtifactMode, swingsetkeeper.SwingStoreArtifactModeOperational, "The set of artifacts to restore (none, operational, replay, archival, debug)")
+	cmd.Flags().String(FlagExportDataMode, swingsetkeeper.SwingStoreExportDataModeAll, "How to use the export data (all, repair-metadata)")
+	cmd.Flags().String(FlagBase, "", "The directory or archive of the base export of a differential export")
+	cmd.Flags().Uint64(FlagExportHeight, 0, "The height of the export to restore from a content-addressed export directory (0 means the latest export)")
+
+	return cmd
+}
+
+// verifySwingStoreExportCmd creates the "verify-swingstore-export" command,
+// which checks the artifacts of a swing-store export directory against the
+// swing-store "export data" of the node's application DB.
+func (ac appCreator) verifySwingStoreExportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-swingstore-export",
+		Short: "Verify the artifacts of a swing-store export directory or archive",
+		Long: `Verify the artifacts of a swing-store export directory or archive against the trusted
+swing-store export data of the node's application DB at the export's height,
+reporting any missing, extra or corrupt artifact. The JS swing-store is not
+involved, and the node must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			artifactMode, _ := cmd.Flags().GetString(FlagArtifactMode)
+			provider, location, closeExport, err := openSwingStoreExport(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeExport()
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			if height == -1 {
+				height = int64(provider.BlockHeight)
+			}
+
+			app, closeApp, err := ac.newSwingStoreApp(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer closeApp()
+
+			ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return err
+			}
+			ctx := sdk.NewContext(ms, tmproto.Header{Height: height}, false, app.Logger())
+			exportDataReader := agoric.NewKVIteratorReader(app.SwingSetKeeper.GetSwingStore(ctx).Iterator(nil, nil))
+
+			verification, err := swingsetkeeper.VerifySwingStoreExport(exportDataReader, provider, artifactMode)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(verification, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+
+			if !verification.IsValid() {
+				return fmt.Errorf("swing-store export in %s failed verification against height %d", location, height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagExportDir, "", "The directory containing the swing-store export")
+	cmd.Flags().String(FlagArchive, "", "The .tar.zst archive file containing the swing-store export")
+	cmd.Flags().String(FlagArtifactMode, swingsetkeeper.SwingStoreArtifactModeOperational, "The set of artifacts the export must contain (none, operational, replay, archival, debug)")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Verify against the export data at a particular height (-1 means the export's height)")
+	cmd.Flags().Uint64(FlagExportHeight, 0, "The height of the export to verify in a content-addressed export directory (0 means the latest export)")
+
+	return cmd
+}
+
+// newSwingStoreApp creates an app loaded at the latest height of the node
+// home's application DB, returning it with a function closing that DB. If
+// needVM is true, it first launches the VM so that the app's
+// SwingStoreExportsHandler can communicate with the JS swing-store.
+func (ac appCreator) newSwingStoreApp(cmd *cobra.Command, needVM bool) (*gaia.GaiaApp, func() error, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	serverCtx.Config.SetRoot(homeDir)
+
+	// The VM is launched the same way as for the "export" command, which may
+	// result in reentering this command with the VM controller set.
+	if needVM && OnExportHook != nil {
+		if err := OnExportHook(serverCtx.Logger, serverCtx.Viper); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), serverCtx.Config.DBDir())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := gaia.NewAgoricApp(
+		ac.sender,
+		serverCtx.Logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		homeDir,
+		cast.ToUint(serverCtx.Viper.Get(server.FlagInvCheckPeriod)),
+		ac.encCfg,
+		serverCtx.Viper,
+	)
+	return app, db.Close, nil
+}
+
+// getSwingStoreExportLocation returns the export directory or the archive path
+// specified on the command line, requiring exactly one of them.
+func getSwingStoreExportLocation(cmd *cobra.Command) (exportDir string, archivePath string, err error) {
+	exportDir, _ = cmd.Flags().GetString(FlagExportDir)
+	archivePath, _ = cmd.Flags().GetString(FlagArchive)
+	if (exportDir == "") == (archivePath == "") {
+		return "", "", fmt.Errorf("exactly one of --%s or --%s must be specified", FlagExportDir, FlagArchive)
+	}
+	return exportDir, archivePath, nil
+}
+
+// openSwingStoreExport opens the swing-store export directory or archive
+// specified on the command line, returning a provider for the export, the
+// location of the export, and a function to release the export.
+func openSwingStoreExport(cmd *cobra.Command) (swingsetkeeper.SwingStoreExportProvider, string, func() error, error) {
+	exportDir, archivePath, err := getSwingStoreExportLocation(cmd)
+	if err != nil {
+		return swingsetkeeper.SwingStoreExportProvider{}, "", nil, err
+	}
+	exportHeight, _ := cmd.Flags().GetUint64(FlagExportHeight)
+	if exportHeight != 0 && archivePath != "" {
+		return swingsetkeeper.SwingStoreExportProvider{}, "", nil, fmt.Errorf("--%s cannot be used with --%s", FlagExportHeight, FlagArchive)
+	}
+	return openSwingStoreExportAt(exportDir, archivePath, exportHeight)
+}
+
+// openSwingStoreExportPath opens the swing-store export at location, which
+// is either an export directory or an archive file.
+func openSwingStoreExportPath(location string) (swingsetkeeper.SwingStoreExportProvider, string, func() error, error) {
+	info, err := os.Stat(location)
+	if err != nil {
+		return swingsetkeeper.SwingStoreExportProvider{}, "", nil, err
+	}
+	if info.IsDir() {
+		return openSwingStoreExportAt(location, "", 0)
+	}
+	return openSwingStoreExportAt("", location, 0)
+}
+
+// openSwingStoreExportAt opens the swing-store export in exportDir if not
+// empty, or in archivePath otherwise. A non-zero exportHeight selects the
+// export at that height in a content-addressed export directory.
+func openSwingStoreExportAt(exportDir string, archivePath string, exportHeight uint64) (swingsetkeeper.SwingStoreExportProvider, string, func() error, error) {
+	if exportDir != "" {
+		provider, err := swingsetkeeper.OpenSwingStoreExportDirectory(exportDir, exportHeight)
+		return provider, exportDir, func() error { return nil }, err
+	}
+
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return swingsetkeeper.SwingStoreExportProvider{}, "", nil, err
+	}
+	provider, err := swingsetkeeper.OpenSwingStoreExportArchive(archiveFile)
+	if err != nil {
+		archiveFile.Close()
+		return swingsetkeeper.SwingStoreExportProvider{}, "", nil, err
+	}
+	return provider, archivePath, archiveFile.Close, nil
+}
+
+// ensureEmptyDirectory creates dir if it does not exist, and otherwise
+// verifies that it is empty.
+func ensureEmptyDirectory(dir string) error {
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("directory %s is not empty", dir)
+	}
+	return nil
+}
+
+// swingStoreExportEventHandler saves a swing-store export using writeExport.
+type swingStoreExportEventHandler struct {
+	blockHeight uint64
+	writeExport func(provider swingsetkeeper.SwingStoreExportProvider) error
+}
+
+func (eventHandler swingStoreExportEventHandler) OnExportStarted(height uint64, retrieveSwingStoreExport func() error) error {
+	return retrieveSwingStoreExport()
+}
+
+func (eventHandler swingStoreExportEventHandler) OnExportRetrieved(provider swingsetkeeper.SwingStoreExportProvider) error {
+	if eventHandler.blockHeight != provider.BlockHeight {
+		return fmt.Errorf("swing-store export block height (%d) doesn't match requested height (%d)", provider.BlockHeight, eventHandler.blockHeight)
+	}
+
+	return eventHandler.writeExport(provider)
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/lien/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const FlagDenom = "denom"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	lienQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the lien module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	lienQueryCmd.AddCommand(
+		GetCmdQueryLien(),
+		GetCmdQueryAccountState(),
+		GetCmdQueryLiens(),
+	)
+
+	return lienQueryCmd
+}
+
+// GetCmdQueryLien implements the query lien command.
+func GetCmdQueryLien() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lien [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the lien on an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Lien(cmd.Context(), &types.QueryLienRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Lien)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccountState implements the query account-state command.
+func GetCmdQueryAccountState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-state [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total, bonded, unbonding, locked, liened and unvested coins of an account",
+		Long: `Query the total, bonded, unbonding, locked, liened and unvested coins of an
+account, which together explain how much of it can be transferred.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountState(cmd.Context(), &types.QueryAccountStateRequest{
+				Address: args[0],
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "only show the coins of this denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLiens implements the query liens command.
+func GetCmdQueryLiens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liens",
+		Args:  cobra.NoArgs,
+		Short: "Query all the liens",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Liens(cmd.Context(), &types.QueryLiensRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liens")
+	return cmd
+}
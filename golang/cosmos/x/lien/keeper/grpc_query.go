@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/lien/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (k Querier) Lien(c context.Context, req *types.QueryLienRequest) (*types.QueryLienResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lien := k.GetLien(ctx, addr)
+
+	return &types.QueryLienResponse{
+		Lien: lien,
+	}, nil
+}
+
+// onlyDenom returns the coins of denom, or all the coins if denom is empty.
+func onlyDenom(coins sdk.Coins, denom string) sdk.Coins {
+	if denom == "" {
+		return coins
+	}
+	return sdk.NewCoins(sdk.NewCoin(denom, coins.AmountOf(denom)))
+}
+
+func (k Querier) AccountState(c context.Context, req *types.QueryAccountStateRequest) (*types.QueryAccountStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", req.Denom, err)
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state := k.GetAccountState(ctx, addr)
+
+	return &types.QueryAccountStateResponse{
+		Total:     onlyDenom(state.Total, req.Denom),
+		Bonded:    onlyDenom(state.Bonded, req.Denom),
+		Unbonding: onlyDenom(state.Unbonding, req.Denom),
+		Locked:    onlyDenom(state.Locked, req.Denom),
+		Liened:    onlyDenom(state.Liened, req.Denom),
+		Unvested:  onlyDenom(state.Unvested, req.Denom),
+	}, nil
+}
+
+func (k Querier) Liens(c context.Context, req *types.QueryLiensRequest) (*types.QueryLiensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	liens, pageRes, err := k.PaginateLiens(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLiensResponse{
+		Liens:      liens,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/lien/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestQueryLien(t *testing.T) {
+	ctx, _, _, _, keeper := makeTestKit().expand()
+	querier := Querier{Keeper: keeper}
+	c := sdk.WrapSDKContext(ctx)
+
+	keeper.SetLien(ctx, addr1, types.Lien{Coins: ubld(123)})
+
+	res, err := querier.Lien(c, &types.QueryLienRequest{Address: addr1.String()})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Lien.Coins.IsEqual(ubld(123)) {
+		t.Errorf("got lien %v, want %s", res.Lien, ubld(123))
+	}
+
+	res, err = querier.Lien(c, &types.QueryLienRequest{Address: addr2.String()})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Lien.Coins.IsZero() {
+		t.Errorf("got lien %v, want empty", res.Lien)
+	}
+
+	if _, err := querier.Lien(c, &types.QueryLienRequest{Address: "foo"}); err == nil {
+		t.Errorf("got no error for an invalid address")
+	}
+}
+
+func TestQueryAccountState(t *testing.T) {
+	tk := makeTestKit()
+	ctx, _, _, _, keeper := tk.expand()
+	querier := Querier{Keeper: keeper}
+	c := sdk.WrapSDKContext(ctx)
+
+	state := types.AccountState{
+		Total:  sdk.NewCoins(sdk.NewInt64Coin("ubld", 10), sdk.NewInt64Coin("urun", 5)),
+		Bonded: ubld(7),
+		Liened: ubld(3),
+	}
+	tk.initAccount(t, addr2, addr1, state)
+
+	res, err := querier.AccountState(c, &types.QueryAccountStateRequest{Address: addr1.String()})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Total.IsEqual(state.Total) || !res.Bonded.IsEqual(state.Bonded) || !res.Liened.IsEqual(state.Liened) {
+		t.Errorf("got account state %v, want %v", res, state)
+	}
+
+	res, err = querier.AccountState(c, &types.QueryAccountStateRequest{Address: addr1.String(), Denom: "urun"})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantTotal := sdk.NewCoins(sdk.NewInt64Coin("urun", 5))
+	if !res.Total.IsEqual(wantTotal) || !res.Bonded.IsZero() || !res.Liened.IsZero() {
+		t.Errorf("got urun account state %v, want total %s only", res, wantTotal)
+	}
+
+	if _, err := querier.AccountState(c, &types.QueryAccountStateRequest{Address: addr1.String(), Denom: "?"}); err == nil {
+		t.Errorf("got no error for an invalid denom")
+	}
+}
+
+func TestQueryLiens(t *testing.T) {
+	ctx, _, _, _, keeper := makeTestKit().expand()
+	querier := Querier{Keeper: keeper}
+	c := sdk.WrapSDKContext(ctx)
+
+	addrs := []sdk.AccAddress{addr1, addr2, addr3}
+	for i, addr := range addrs {
+		keeper.SetLien(ctx, addr, types.Lien{Coins: ubld(int64(i + 1))})
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+
+	res, err := querier.Liens(c, &types.QueryLiensRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(res.Liens) != 2 || res.Liens[0].Address != addrs[0].String() || res.Liens[1].Address != addrs[1].String() {
+		t.Errorf("got first page %v, want liens of %v", res.Liens, addrs[:2])
+	}
+	if !bytes.Equal(res.Pagination.NextKey, addrs[2]) || res.Pagination.Total != 3 {
+		t.Errorf("got first page pagination %v, want next key %s and total 3", res.Pagination, addrs[2])
+	}
+
+	res, err = querier.Liens(c, &types.QueryLiensRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(res.Liens) != 1 || res.Liens[0].Address != addrs[2].String() || res.Pagination.NextKey != nil {
+		t.Errorf("got last page %v, want lien of %s", res, addrs[2])
+	}
+
+	res, err = querier.Liens(c, &types.QueryLiensRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(res.Liens) != 2 || res.Liens[0].Address != addrs[1].String() {
+		t.Errorf("got offset page %v, want liens of %v", res.Liens, addrs[1:])
+	}
+}
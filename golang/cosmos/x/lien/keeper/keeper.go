@@ -7,8 +7,10 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/lien/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -20,6 +22,7 @@ type Keeper interface {
 	GetLien(ctx sdk.Context, addr sdk.AccAddress) types.Lien
 	SetLien(ctx sdk.Context, addr sdk.AccAddress, lien types.Lien)
 	IterateLiens(ctx sdk.Context, cb func(addr sdk.AccAddress, lien types.Lien) bool)
+	PaginateLiens(ctx sdk.Context, pageReq *query.PageRequest) ([]types.AccountLien, *query.PageResponse, error)
 	ChangeLien(ctx sdk.Context, addr sdk.AccAddress, denom string, delta sdk.Int) (sdk.Int, error)
 	GetAccountState(ctx sdk.Context, addr sdk.AccAddress) types.AccountState
 	BondDenom(ctx sdk.Context) string
@@ -104,6 +107,26 @@ func (lk keeperImpl) IterateLiens(ctx sdk.Context, cb func(addr sdk.AccAddress,
 	}
 }
 
+// PaginateLiens returns the nonzero liens selected by pageReq, in the order of
+// their address bytes.
+func (lk keeperImpl) PaginateLiens(ctx sdk.Context, pageReq *query.PageRequest) ([]types.AccountLien, *query.PageResponse, error) {
+	liens := []types.AccountLien{}
+	store := prefix.NewStore(ctx.KVStore(lk.key), nil)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var lien types.Lien
+		if err := lk.cdc.Unmarshal(value, &lien); err != nil {
+			return err
+		}
+		addr := types.LienByAddressDecodeKey(key)
+		liens = append(liens, types.AccountLien{Address: addr.String(), Lien: &lien})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return liens, pageRes, nil
+}
+
 // ChangeLien changes the liened amount of a single denomination in the given account.
 // Either the old or new amount of the denomination can be zero.
 // Liens can always be decreased, but to increase a lien, the new total amount must
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
@@ -73,6 +74,10 @@ func (m *mockLienKeeper) SetLien(ctx sdk.Context, addr sdk.AccAddress, lien type
 func (m *mockLienKeeper) IterateLiens(ctx sdk.Context, cb func(addr sdk.AccAddress, lien types.Lien) bool) {
 }
 
+func (m *mockLienKeeper) PaginateLiens(ctx sdk.Context, pageReq *query.PageRequest) ([]types.AccountLien, *query.PageResponse, error) {
+	return nil, &query.PageResponse{}, nil
+}
+
 func (m *mockLienKeeper) ChangeLien(ctx sdk.Context, addr sdk.AccAddress, denom string, delta sdk.Int) (sdk.Int, error) {
 	state := m.GetAccountState(ctx, addr)
 	oldLiened := state.Liened.AmountOf(denom)
@@ -0,0 +1,1835 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/lien/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryLienRequest is the request type for the Query/Lien RPC method.
+type QueryLienRequest struct {
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryLienRequest) Reset()         { *m = QueryLienRequest{} }
+func (m *QueryLienRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLienRequest) ProtoMessage()    {}
+func (*QueryLienRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{0}
+}
+func (m *QueryLienRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLienRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLienRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLienRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLienRequest.Merge(m, src)
+}
+func (m *QueryLienRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLienRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLienRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLienRequest proto.InternalMessageInfo
+
+func (m *QueryLienRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryLienResponse is the response type for the Query/Lien RPC method.
+type QueryLienResponse struct {
+	// lien is the lien on the account, which is zero if there is none.
+	Lien Lien `protobuf:"bytes,1,opt,name=lien,proto3" json:"lien"`
+}
+
+func (m *QueryLienResponse) Reset()         { *m = QueryLienResponse{} }
+func (m *QueryLienResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLienResponse) ProtoMessage()    {}
+func (*QueryLienResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{1}
+}
+func (m *QueryLienResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLienResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLienResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLienResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLienResponse.Merge(m, src)
+}
+func (m *QueryLienResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLienResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLienResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLienResponse proto.InternalMessageInfo
+
+func (m *QueryLienResponse) GetLien() Lien {
+	if m != nil {
+		return m.Lien
+	}
+	return Lien{}
+}
+
+// QueryAccountStateRequest is the request type for the Query/AccountState RPC
+// method.
+type QueryAccountStateRequest struct {
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom restricts the state to a single denom if not empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAccountStateRequest) Reset()         { *m = QueryAccountStateRequest{} }
+func (m *QueryAccountStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStateRequest) ProtoMessage()    {}
+func (*QueryAccountStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{2}
+}
+func (m *QueryAccountStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStateRequest.Merge(m, src)
+}
+func (m *QueryAccountStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountStateRequest proto.InternalMessageInfo
+
+func (m *QueryAccountStateRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountStateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryAccountStateResponse is the response type for the Query/AccountState
+// RPC method.
+type QueryAccountStateResponse struct {
+	// total is the sum of the bank balance and the bonded and unbonding coins.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// bonded is the amount of coins bonded to validators.
+	Bonded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=bonded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bonded"`
+	// unbonding is the amount of coins in the process of unbonding.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
+	// locked is the amount of coins still locked by a vesting schedule.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// liened is the amount of coins liened.
+	Liened github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=liened,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liened"`
+	// unvested is the amount of coins still subject to vesting.
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+}
+
+func (m *QueryAccountStateResponse) Reset()         { *m = QueryAccountStateResponse{} }
+func (m *QueryAccountStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStateResponse) ProtoMessage()    {}
+func (*QueryAccountStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{3}
+}
+func (m *QueryAccountStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStateResponse.Merge(m, src)
+}
+func (m *QueryAccountStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountStateResponse proto.InternalMessageInfo
+
+func (m *QueryAccountStateResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *QueryAccountStateResponse) GetBonded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bonded
+	}
+	return nil
+}
+
+func (m *QueryAccountStateResponse) GetUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+func (m *QueryAccountStateResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryAccountStateResponse) GetLiened() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liened
+	}
+	return nil
+}
+
+func (m *QueryAccountStateResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+// QueryLiensRequest is the request type for the Query/Liens RPC method.
+type QueryLiensRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiensRequest) Reset()         { *m = QueryLiensRequest{} }
+func (m *QueryLiensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiensRequest) ProtoMessage()    {}
+func (*QueryLiensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{4}
+}
+func (m *QueryLiensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiensRequest.Merge(m, src)
+}
+func (m *QueryLiensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiensRequest proto.InternalMessageInfo
+
+func (m *QueryLiensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLiensResponse is the response type for the Query/Liens RPC method.
+type QueryLiensResponse struct {
+	// liens are the nonzero liens, ordered by address bytes.
+	Liens      []AccountLien       `protobuf:"bytes,1,rep,name=liens,proto3" json:"liens"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiensResponse) Reset()         { *m = QueryLiensResponse{} }
+func (m *QueryLiensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiensResponse) ProtoMessage()    {}
+func (*QueryLiensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc9462ca9b654a6b, []int{5}
+}
+func (m *QueryLiensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiensResponse.Merge(m, src)
+}
+func (m *QueryLiensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiensResponse proto.InternalMessageInfo
+
+func (m *QueryLiensResponse) GetLiens() []AccountLien {
+	if m != nil {
+		return m.Liens
+	}
+	return nil
+}
+
+func (m *QueryLiensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryLienRequest)(nil), "agoric.lien.QueryLienRequest")
+	proto.RegisterType((*QueryLienResponse)(nil), "agoric.lien.QueryLienResponse")
+	proto.RegisterType((*QueryAccountStateRequest)(nil), "agoric.lien.QueryAccountStateRequest")
+	proto.RegisterType((*QueryAccountStateResponse)(nil), "agoric.lien.QueryAccountStateResponse")
+	proto.RegisterType((*QueryLiensRequest)(nil), "agoric.lien.QueryLiensRequest")
+	proto.RegisterType((*QueryLiensResponse)(nil), "agoric.lien.QueryLiensResponse")
+}
+
+func init() { proto.RegisterFile("agoric/lien/query.proto", fileDescriptor_bc9462ca9b654a6b) }
+
+var fileDescriptor_bc9462ca9b654a6b = []byte{
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0xcd, 0xa4, 0x99, 0x7e, 0x5f, 0x5d, 0x16, 0xd4, 0xaa, 0x60, 0x3a, 0x2a, 0xd3, 0x6a, 0x44,
+	0x7f, 0x04, 0x74, 0x4c, 0x0b, 0x12, 0x5b, 0x5a, 0x24, 0x90, 0x10, 0x0b, 0x1a, 0x76, 0xb0, 0x72,
+	0x66, 0x2c, 0x63, 0x25, 0xb1, 0xd3, 0xd8, 0x53, 0x51, 0x21, 0x16, 0xb0, 0x66, 0x81, 0xd4, 0xb7,
+	0xe0, 0x49, 0xba, 0xac, 0xc4, 0x86, 0x15, 0xa0, 0x84, 0x0d, 0x6f, 0x81, 0xfc, 0x33, 0xd4, 0x69,
+	0x1b, 0x65, 0x93, 0x6e, 0x92, 0x19, 0xdf, 0xe3, 0x73, 0xce, 0xbd, 0x73, 0xef, 0x05, 0x37, 0x31,
+	0x15, 0x7d, 0x96, 0xa3, 0x0e, 0x23, 0x1c, 0x1d, 0x94, 0xa4, 0x7f, 0x94, 0xf5, 0xfa, 0x42, 0x09,
+	0x38, 0x6f, 0x03, 0x99, 0x0e, 0xc4, 0x8b, 0x54, 0x50, 0x61, 0xce, 0x91, 0x7e, 0xb2, 0x90, 0x78,
+	0x99, 0x0a, 0x41, 0x3b, 0x04, 0xe1, 0x1e, 0x43, 0x98, 0x73, 0xa1, 0xb0, 0x62, 0x82, 0x4b, 0x17,
+	0xbd, 0x93, 0x0b, 0xd9, 0x15, 0x12, 0xb5, 0xb0, 0x24, 0x96, 0x19, 0x1d, 0x6e, 0xb7, 0x88, 0xc2,
+	0xdb, 0xa8, 0x87, 0x29, 0xe3, 0x06, 0xec, 0xb0, 0x89, 0x8f, 0xad, 0x50, 0xb9, 0x60, 0x55, 0xfc,
+	0x86, 0xef, 0x52, 0xff, 0xb8, 0xf3, 0x25, 0xff, 0x9c, 0x12, 0x4e, 0x24, 0x73, 0xf2, 0xe9, 0x3d,
+	0x70, 0x7d, 0x5f, 0x8b, 0xbe, 0x60, 0x84, 0x37, 0xc9, 0x41, 0x49, 0xa4, 0x82, 0x11, 0xf8, 0x0f,
+	0x17, 0x45, 0x9f, 0x48, 0x19, 0x05, 0xab, 0xc1, 0xe6, 0x5c, 0xb3, 0x7a, 0x4d, 0x1f, 0x83, 0x05,
+	0x0f, 0x2d, 0x7b, 0x82, 0x4b, 0x02, 0xef, 0x82, 0x86, 0x26, 0x36, 0xd8, 0xf9, 0x9d, 0x85, 0xcc,
+	0xab, 0x48, 0xa6, 0x81, 0x7b, 0x8d, 0x93, 0x1f, 0x2b, 0xb5, 0xa6, 0x01, 0xa5, 0xcf, 0x41, 0x64,
+	0x18, 0x76, 0xf3, 0x5c, 0x94, 0x5c, 0xbd, 0x52, 0x58, 0x91, 0x89, 0xba, 0x70, 0x11, 0x84, 0x05,
+	0xe1, 0xa2, 0x1b, 0xd5, 0xcd, 0xb9, 0x7d, 0x49, 0x3f, 0x86, 0x60, 0xe9, 0x12, 0x32, 0x67, 0x0b,
+	0x83, 0x50, 0x09, 0x85, 0x3b, 0x51, 0xb0, 0x3a, 0xb3, 0x39, 0xbf, 0xb3, 0x94, 0xd9, 0xe2, 0x65,
+	0xba, 0x78, 0x99, 0x2b, 0x5e, 0xf6, 0x44, 0x30, 0xbe, 0x77, 0x5f, 0xfb, 0xfb, 0xfa, 0x73, 0x65,
+	0x93, 0x32, 0xf5, 0xb6, 0x6c, 0x65, 0xb9, 0xe8, 0x22, 0x57, 0x69, 0xfb, 0xb7, 0x25, 0x8b, 0x36,
+	0x52, 0x47, 0x3d, 0x22, 0xcd, 0x05, 0xd9, 0xb4, 0xcc, 0x30, 0x07, 0xb3, 0x2d, 0xc1, 0x0b, 0x52,
+	0x44, 0xf5, 0xe9, 0x6b, 0x38, 0x6a, 0xc8, 0xc0, 0x5c, 0xc9, 0xf5, 0x33, 0xe3, 0x34, 0x9a, 0x99,
+	0xbe, 0xce, 0x19, 0xbb, 0xce, 0xa7, 0x23, 0xf2, 0x36, 0x29, 0xa2, 0xc6, 0x15, 0xe4, 0x63, 0xa9,
+	0x8d, 0x08, 0x23, 0x9c, 0x14, 0x51, 0x78, 0x15, 0x22, 0x86, 0x1a, 0x52, 0xf0, 0x7f, 0xc9, 0x0f,
+	0x89, 0x54, 0xa4, 0x88, 0x66, 0xa7, 0x2f, 0xf3, 0x8f, 0x3c, 0x7d, 0xe3, 0x4d, 0x84, 0xac, 0x1a,
+	0xf9, 0x29, 0x00, 0x67, 0xb3, 0xeb, 0xe6, 0x62, 0x7d, 0x44, 0xdf, 0xae, 0x90, 0xca, 0xc5, 0x4b,
+	0x4c, 0xab, 0x21, 0x68, 0x7a, 0x37, 0xd3, 0xe3, 0x00, 0x40, 0x9f, 0xdd, 0x75, 0xf6, 0x43, 0x10,
+	0xea, 0x34, 0xa5, 0xeb, 0xec, 0x68, 0x64, 0xe2, 0xdc, 0x2c, 0x78, 0x83, 0x67, 0xc1, 0xf0, 0xd9,
+	0x88, 0xa9, 0xba, 0x31, 0xb5, 0x31, 0xd1, 0x94, 0x95, 0xf4, 0x5d, 0xed, 0xfc, 0xa9, 0x83, 0xd0,
+	0xb8, 0x82, 0x6d, 0xd0, 0xd0, 0x3a, 0xf0, 0xd6, 0x88, 0x83, 0xf3, 0xfb, 0x24, 0x4e, 0xc6, 0x85,
+	0x2d, 0x79, 0x7a, 0xfb, 0xd3, 0xb7, 0xdf, 0xc7, 0xf5, 0x04, 0x2e, 0xa3, 0xf3, 0xfb, 0x4b, 0xa2,
+	0xf7, 0x6e, 0x05, 0x7c, 0x80, 0x9f, 0x03, 0x70, 0xcd, 0x1f, 0x74, 0xb8, 0x76, 0x91, 0xf6, 0x92,
+	0xad, 0x12, 0xaf, 0x4f, 0x82, 0x39, 0x17, 0x5b, 0xc6, 0xc5, 0x06, 0x5c, 0x1b, 0x71, 0x81, 0x2d,
+	0xd4, 0x33, 0x82, 0xa4, 0x51, 0x2f, 0x40, 0x68, 0xbe, 0x0a, 0x1c, 0x93, 0x5d, 0xd5, 0x0c, 0xf1,
+	0xca, 0xd8, 0xb8, 0x13, 0x8e, 0x8d, 0xf0, 0x22, 0x84, 0x17, 0xd3, 0xdf, 0xdb, 0x3f, 0x19, 0x24,
+	0xc1, 0xe9, 0x20, 0x09, 0x7e, 0x0d, 0x92, 0xe0, 0xcb, 0x30, 0xa9, 0x9d, 0x0e, 0x93, 0xda, 0xf7,
+	0x61, 0x52, 0x7b, 0xfd, 0xc8, 0x6b, 0xd6, 0x5d, 0x7b, 0xcf, 0x5e, 0x37, 0xcd, 0x4a, 0x45, 0x07,
+	0x73, 0x5a, 0x75, 0xf1, 0x3b, 0x4b, 0x69, 0x3a, 0xb8, 0x35, 0x6b, 0x16, 0xff, 0x83, 0xbf, 0x03,
+	0x00, 0x00, 0x15, 0x3a, 0xfd, 0xd3, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Lien queries the lien on an account.
+	Lien(ctx context.Context, in *QueryLienRequest, opts ...grpc.CallOption) (*QueryLienResponse, error)
+	// AccountState queries the abstract state of an account relevant to liens.
+	AccountState(ctx context.Context, in *QueryAccountStateRequest, opts ...grpc.CallOption) (*QueryAccountStateResponse, error)
+	// Liens queries all the nonzero liens.
+	Liens(ctx context.Context, in *QueryLiensRequest, opts ...grpc.CallOption) (*QueryLiensResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Lien(ctx context.Context, in *QueryLienRequest, opts ...grpc.CallOption) (*QueryLienResponse, error) {
+	out := new(QueryLienResponse)
+	err := c.cc.Invoke(ctx, "/agoric.lien.Query/Lien", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountState(ctx context.Context, in *QueryAccountStateRequest, opts ...grpc.CallOption) (*QueryAccountStateResponse, error) {
+	out := new(QueryAccountStateResponse)
+	err := c.cc.Invoke(ctx, "/agoric.lien.Query/AccountState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Liens(ctx context.Context, in *QueryLiensRequest, opts ...grpc.CallOption) (*QueryLiensResponse, error) {
+	out := new(QueryLiensResponse)
+	err := c.cc.Invoke(ctx, "/agoric.lien.Query/Liens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Lien queries the lien on an account.
+	Lien(context.Context, *QueryLienRequest) (*QueryLienResponse, error)
+	// AccountState queries the abstract state of an account relevant to liens.
+	AccountState(context.Context, *QueryAccountStateRequest) (*QueryAccountStateResponse, error)
+	// Liens queries all the nonzero liens.
+	Liens(context.Context, *QueryLiensRequest) (*QueryLiensResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Lien(ctx context.Context, req *QueryLienRequest) (*QueryLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lien not implemented")
+}
+func (*UnimplementedQueryServer) AccountState(ctx context.Context, req *QueryAccountStateRequest) (*QueryAccountStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountState not implemented")
+}
+func (*UnimplementedQueryServer) Liens(ctx context.Context, req *QueryLiensRequest) (*QueryLiensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liens not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Lien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.lien.Query/Lien",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lien(ctx, req.(*QueryLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.lien.Query/AccountState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountState(ctx, req.(*QueryAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Liens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Liens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.lien.Query/Liens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Liens(ctx, req.(*QueryLiensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.lien.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lien",
+			Handler:    _Query_Lien_Handler,
+		},
+		{
+			MethodName: "AccountState",
+			Handler:    _Query_AccountState_Handler,
+		},
+		{
+			MethodName: "Liens",
+			Handler:    _Query_Liens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/lien/query.proto",
+}
+
+func (m *QueryLienRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLienRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLienRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLienResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLienResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLienResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lien.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Liened) > 0 {
+		for iNdEx := len(m.Liened) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liened[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bonded) > 0 {
+		for iNdEx := len(m.Bonded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Liens) > 0 {
+		for iNdEx := len(m.Liens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLienRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLienResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lien.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Bonded) > 0 {
+		for _, e := range m.Bonded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Liened) > 0 {
+		for _, e := range m.Liened {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liens) > 0 {
+		for _, e := range m.Liens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLienRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLienRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLienRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLienResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLienResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLienResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lien", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lien.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonded = append(m.Bonded, types.Coin{})
+			if err := m.Bonded[len(m.Bonded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbonding = append(m.Unbonding, types.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liened", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liened = append(m.Liened, types.Coin{})
+			if err := m.Liened[len(m.Liened)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liens = append(m.Liens, AccountLien{})
+			if err := m.Liens[len(m.Liens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,14 +1,33 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagKeysOnly = "keys-only"
+	FlagPrefix   = "prefix"
+	FlagNodeB    = "node-b"
+
+	// exportDataPageLimit is the number of entries fetched per query when
+	// reading the whole export data
+	exportDataPageLimit = 1000
+)
+
 func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -21,6 +40,11 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 		GetCmdGetEgress(storeKey),
 		GetCmdQueryParams(storeKey),
 		GetCmdMailbox(storeKey),
+		GetCmdHighPrioritySenders(storeKey),
+		GetCmdExportData(storeKey),
+		GetCmdExportDataEntry(storeKey),
+		GetCmdExportDataDiff(storeKey),
+		GetCmdExportDataStats(storeKey),
 	)
 
 	return swingsetQueryCmd
@@ -115,3 +139,252 @@ func GetCmdMailbox(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdHighPrioritySenders queries the high priority senders and their namespaces
+func GetCmdHighPrioritySenders(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "high-priority-senders",
+		Short: "get high priority senders and their namespaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HighPrioritySenders(cmd.Context(), &types.QueryHighPrioritySendersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "high priority senders")
+	return cmd
+}
+
+// GetCmdExportData lists the swing-store export data entries
+func GetCmdExportData(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-data [prefix]",
+		Short: "list swing-store export data entries, optionally restricted to a key prefix (e.g. kv., transcript.v1., snapshot., bundle.)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			keysOnly, err := cmd.Flags().GetBool(FlagKeysOnly)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySwingStoreExportDataRequest{
+				KeysOnly:   keysOnly,
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.Prefix = args[0]
+			}
+
+			res, err := queryClient.SwingStoreExportData(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagKeysOnly, false, "omit the values of the entries")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "export data")
+	return cmd
+}
+
+// GetCmdExportDataEntry queries a swing-store export data entry
+func GetCmdExportDataEntry(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-data-entry <key>",
+		Short: "get the value of a swing-store export data entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwingStoreExportDataEntry(cmd.Context(), &types.QuerySwingStoreExportDataEntryRequest{
+				Key: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExportDataDiff diffs the swing-store export data between two heights,
+// or between two nodes
+func GetCmdExportDataDiff(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-data-diff <height-a> <height-b>",
+		Short: "diff the swing-store export data between two heights",
+		Long: `Diff the swing-store export data between two heights.
+
+With --node-b, the export data at height-b is read from another node, which
+allows comparing the export data of two nodes at the same height, for example
+to find the vat whose transcript or kv entries diverged.
+The differing entries are summarized by kind of entry and vat.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			heightA, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-a %q: %w", args[0], err)
+			}
+			heightB, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-b %q: %w", args[1], err)
+			}
+			keyPrefix, err := cmd.Flags().GetString(FlagPrefix)
+			if err != nil {
+				return err
+			}
+
+			clientCtxB := clientCtx
+			nodeB, err := cmd.Flags().GetString(FlagNodeB)
+			if err != nil {
+				return err
+			}
+			if nodeB != "" {
+				nodeClient, err := client.NewClientFromNode(nodeB)
+				if err != nil {
+					return err
+				}
+				clientCtxB = clientCtxB.WithClient(nodeClient).WithNodeURI(nodeB)
+			}
+
+			diff, err := keeper.DiffSwingStoreExportData(
+				newExportDataReader(cmd.Context(), types.NewQueryClient(clientCtx.WithHeight(heightA)), keyPrefix),
+				newExportDataReader(cmd.Context(), types.NewQueryClient(clientCtxB.WithHeight(heightB)), keyPrefix),
+			)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(diff)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagPrefix, "", "only diff the entries whose key starts with this prefix")
+	cmd.Flags().String(FlagNodeB, "", "<host>:<port> to Tendermint RPC interface of the node to read height-b from (defaults to --node)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExportDataStats queries the size of the swing-store export data
+func GetCmdExportDataStats(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-data-stats",
+		Short: "get the number of entries and size of the swing-store export data by kind of entry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwingStoreExportDataStats(cmd.Context(), &types.QuerySwingStoreExportDataStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Stats)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// exportDataReader is a KVEntryReader of the swing-store export data entries
+// with a key prefix, fetched one page at a time.
+type exportDataReader struct {
+	ctx         context.Context
+	queryClient types.QueryClient
+	keyPrefix   string
+	entries     []types.SwingStoreExportDataEntry
+	nextKey     []byte
+	done        bool
+}
+
+var _ agoric.KVEntryReader = &exportDataReader{}
+
+func newExportDataReader(ctx context.Context, queryClient types.QueryClient, keyPrefix string) *exportDataReader {
+	return &exportDataReader{ctx: ctx, queryClient: queryClient, keyPrefix: keyPrefix}
+}
+
+func (reader *exportDataReader) Read() (agoric.KVEntry, error) {
+	for len(reader.entries) == 0 {
+		if reader.done {
+			return agoric.KVEntry{}, io.EOF
+		}
+		res, err := reader.queryClient.SwingStoreExportData(reader.ctx, &types.QuerySwingStoreExportDataRequest{
+			Prefix: reader.keyPrefix,
+			Pagination: &query.PageRequest{
+				Key:   reader.nextKey,
+				Limit: exportDataPageLimit,
+			},
+		})
+		if err != nil {
+			return agoric.KVEntry{}, err
+		}
+		reader.entries = res.Entries
+		reader.nextKey = nil
+		if res.Pagination != nil {
+			reader.nextKey = res.Pagination.NextKey
+		}
+		reader.done = len(reader.nextKey) == 0
+	}
+
+	entry := reader.entries[0]
+	reader.entries = reader.entries[1:]
+	return agoric.NewKVEntry(entry.Key, entry.Value), nil
+}
+
+func (reader *exportDataReader) Close() error {
+	return nil
+}
@@ -20,8 +20,12 @@ import (
 )
 
 const (
-	FlagAllowSpend = "allow-spend"
-	FlagCompress   = "compress"
+	FlagAllowSpend  = "allow-spend"
+	FlagCompress    = "compress"
+	FlagCompression = "compression"
+	FlagNamespace   = "namespace"
+	FlagAdd         = "add"
+	FlagRemove      = "remove"
 )
 
 func GetTxCmd(storeKey string) *cobra.Command {
@@ -38,6 +42,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		GetCmdProvisionOne(),
 		GetCmdInstallBundle(),
 		GetCmdWalletAction(),
+		GetCmdWalletActionBatch(),
 	)
 
 	return swingsetTxCmd
@@ -139,6 +144,14 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 				return err
 			}
 			if compress {
+				compression, err := cmd.Flags().GetString(FlagCompression)
+				if err != nil {
+					return err
+				}
+				msg.Compression, err = types.ParseCompressionAlgorithm(compression)
+				if err != nil {
+					return err
+				}
 				err = msg.Compress()
 				if err != nil {
 					return err
@@ -154,6 +167,7 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 		},
 	}
 	cmd.Flags().Bool(FlagCompress, true, "Compress the bundle in transit")
+	cmd.Flags().String(FlagCompression, "gzip", "Compression algorithm to use in transit (gzip, zstd or brotli)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -234,6 +248,36 @@ func GetCmdWalletAction() *cobra.Command {
 	return cmd
 }
 
+// GetCmdWalletActionBatch is the CLI command for sending a WalletActionBatch transaction
+func GetCmdWalletActionBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet-action-batch <action JSON>...",
+		Short: "perform several wallet actions in a single message",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spend, err := cmd.Flags().GetBool(FlagAllowSpend)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWalletActionBatch(clientCtx.GetFromAddress(), args, spend)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllowSpend, false, "Allow the actions to spend assets")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitCoreEvalProposal is the CLI command for submitting a "CoreEval"
 // governance proposal via `agd tx gov submit-proposal swingset-core-eval ...`.
 func NewCmdSubmitCoreEvalProposal() *cobra.Command {
@@ -321,3 +365,80 @@ Specify at least one pair of permit.json and code.js files`,
 
 	return cmd
 }
+
+// NewCmdSubmitHighPrioritySendersProposal is the CLI command for submitting a
+// "HighPrioritySenders" governance proposal via
+// `agd tx gov submit-proposal swingset-high-priority-senders ...`.
+func NewCmdSubmitHighPrioritySendersProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swingset-high-priority-senders",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to add or remove high priority senders",
+		Long: `Submit a proposal to add or remove high priority senders in a namespace along with an initial deposit.
+Specify at least one address with --add or --remove`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetStringSlice(FlagAdd)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewHighPrioritySendersProposal(title, description, namespace, add, remove)
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit for proposal")
+	cmd.Flags().String(FlagNamespace, "", "namespace of the high priority senders")
+	cmd.Flags().StringSlice(FlagAdd, nil, "addresses to add as high priority senders")
+	cmd.Flags().StringSlice(FlagRemove, nil, "addresses to remove from high priority senders")
+
+	return cmd
+}
@@ -6,5 +6,6 @@ import (
 )
 
 var (
-	CoreEvalProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCoreEvalProposal)
+	CoreEvalProposalHandler            = govclient.NewProposalHandler(cli.NewCmdSubmitCoreEvalProposal)
+	HighPrioritySendersProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitHighPrioritySendersProposal)
 )
@@ -2,7 +2,14 @@ package swingset
 
 import (
 	// "os"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
@@ -10,6 +17,34 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// The modes in which the swing-store artifacts of a genesis may be provided.
+const (
+	// SwingStoreArtifactsModeDirectory is the default mode, in which the
+	// artifacts are described by the manifest of the swing-store export
+	// directory. It is represented by an empty mode in the genesis.
+	SwingStoreArtifactsModeDirectory = "directory"
+	// SwingStoreArtifactsModeReference lists each artifact in the genesis by
+	// content hash and path relative to the swing-store export directory.
+	SwingStoreArtifactsModeReference = "reference"
+	// SwingStoreArtifactsModeInline embeds the data of each artifact in the
+	// genesis. Only suitable for small sets of artifacts.
+	SwingStoreArtifactsModeInline = "inline"
+)
+
+// DefaultSwingStoreInlineArtifactsMaxSize is the default maximum total size
+// in bytes of the artifacts embedded in a genesis exported in inline mode.
+const DefaultSwingStoreInlineArtifactsMaxSize = 16 * 1024 * 1024
+
+// IsValidSwingStoreArtifactsMode returns whether mode is a valid swing-store
+// artifacts mode. The empty mode is equivalent to the directory mode.
+func IsValidSwingStoreArtifactsMode(mode string) bool {
+	switch mode {
+	case "", SwingStoreArtifactsModeDirectory, SwingStoreArtifactsModeReference, SwingStoreArtifactsModeInline:
+		return true
+	}
+	return false
+}
+
 func ValidateGenesis(data *types.GenesisState) error {
 	if data == nil {
 		return fmt.Errorf("swingset genesis data cannot be nil")
@@ -17,9 +52,221 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	return validateGenesisSwingStoreArtifacts(data)
+}
+
+// validateGenesisSwingStoreArtifacts checks that the listed swing-store
+// artifacts are well formed, and that all the artifacts required to restore
+// the swing-store from the genesis export data are present.
+// It cannot check the presence of artifacts in directory mode, nor the
+// content of referenced files, which are verified at InitGenesis and by
+// ValidateGenesisSwingStoreArtifactFiles.
+func validateGenesisSwingStoreArtifacts(data *types.GenesisState) error {
+	mode := data.SwingStoreArtifactsMode
+	if !IsValidSwingStoreArtifactsMode(mode) {
+		return fmt.Errorf("invalid swing-store artifacts mode %q", mode)
+	}
+	if mode == "" || mode == SwingStoreArtifactsModeDirectory {
+		if len(data.SwingStoreArtifacts) != 0 {
+			return fmt.Errorf("swing-store artifacts cannot be listed in %s mode", SwingStoreArtifactsModeDirectory)
+		}
+		return nil
+	}
+	if len(data.SwingStoreExportData) == 0 {
+		if len(data.SwingStoreArtifacts) != 0 {
+			return fmt.Errorf("swing-store artifacts listed without swing-store export data")
+		}
+		return nil
+	}
+
+	listed := make(map[string]bool, len(data.SwingStoreArtifacts))
+	for _, artifact := range data.SwingStoreArtifacts {
+		if err := validateGenesisSwingStoreArtifact(mode, artifact); err != nil {
+			return err
+		}
+		if listed[artifact.Name] {
+			return fmt.Errorf("duplicate swing-store artifact %s", artifact.Name)
+		}
+		listed[artifact.Name] = true
+	}
+
+	required, err := keeper.RequiredSwingStoreArtifacts(
+		agoric.NewSwingStoreExportDataEntriesReader(data.SwingStoreExportData),
+		keeper.SwingStoreArtifactModeReplay,
+	)
+	if err != nil {
+		return err
+	}
+	missing := []string{}
+	for _, name := range required {
+		if !listed[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("missing swing-store artifacts: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func validateGenesisSwingStoreArtifact(mode string, artifact *types.SwingStoreGenesisArtifact) error {
+	if artifact.Name == "" ||
+		artifact.Name == keeper.UntrustedExportDataArtifactName ||
+		artifact.Name == keeper.SwingStoreExportDeltaArtifactName {
+		return fmt.Errorf("invalid swing-store artifact name %q", artifact.Name)
+	}
+	if hash, err := hex.DecodeString(artifact.Sha256); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("invalid sha256 %q for swing-store artifact %s", artifact.Sha256, artifact.Name)
+	}
+
+	switch mode {
+	case SwingStoreArtifactsModeReference:
+		if len(artifact.Data) != 0 {
+			return fmt.Errorf("swing-store artifact %s cannot have inline data in %s mode", artifact.Name, mode)
+		}
+		if _, err := genesisArtifactFilePath("", artifact.URI); err != nil {
+			return fmt.Errorf("swing-store artifact %s: %w", artifact.Name, err)
+		}
+	case SwingStoreArtifactsModeInline:
+		if artifact.URI != "" {
+			return fmt.Errorf("swing-store artifact %s cannot have a uri in %s mode", artifact.Name, mode)
+		}
+		if err := verifyGenesisArtifactData(artifact, artifact.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// genesisArtifactFilePath resolves the uri of a referenced artifact relative
+// to the swing-store export directory. The uri must be a clean relative path
+// which does not escape the directory.
+func genesisArtifactFilePath(swingStoreExportDir string, uri string) (string, error) {
+	if uri == "" ||
+		path.IsAbs(uri) ||
+		path.Clean(uri) != uri ||
+		uri == ".." || strings.HasPrefix(uri, "../") ||
+		strings.Contains(uri, "\\") {
+		return "", fmt.Errorf("invalid uri %q", uri)
+	}
+	return filepath.Join(swingStoreExportDir, filepath.FromSlash(uri)), nil
+}
+
+func genesisArtifactSha256(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func verifyGenesisArtifactData(artifact *types.SwingStoreGenesisArtifact, data []byte) error {
+	if hash := genesisArtifactSha256(data); hash != artifact.Sha256 {
+		return fmt.Errorf("swing-store artifact %s has sha256 %s, expected %s", artifact.Name, hash, artifact.Sha256)
+	}
 	return nil
 }
 
+// readGenesisSwingStoreArtifact returns the verified data of a swing-store
+// artifact listed in the genesis.
+func readGenesisSwingStoreArtifact(swingStoreExportDir string, artifact *types.SwingStoreGenesisArtifact) ([]byte, error) {
+	data := artifact.Data
+	if artifact.URI != "" {
+		filePath, err := genesisArtifactFilePath(swingStoreExportDir, artifact.URI)
+		if err != nil {
+			return nil, fmt.Errorf("swing-store artifact %s: %w", artifact.Name, err)
+		}
+		data, err = os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("swing-store artifact %s: %w", artifact.Name, err)
+		}
+	}
+	if err := verifyGenesisArtifactData(artifact, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ValidateGenesisSwingStoreArtifactFiles checks that the files of the
+// artifacts referenced by the genesis exist in the swing-store export
+// directory and match their content hash. In directory mode, it checks that
+// the manifest of the swing-store export directory lists all the artifacts
+// required by the genesis export data, and that their files exist.
+func ValidateGenesisSwingStoreArtifactFiles(data *types.GenesisState, swingStoreExportDir string) error {
+	switch data.SwingStoreArtifactsMode {
+	case "", SwingStoreArtifactsModeDirectory:
+		return validateGenesisSwingStoreExportDirectory(data, swingStoreExportDir)
+	case SwingStoreArtifactsModeReference:
+		for _, artifact := range data.SwingStoreArtifacts {
+			if _, err := readGenesisSwingStoreArtifact(swingStoreExportDir, artifact); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateGenesisSwingStoreExportDirectory(data *types.GenesisState, swingStoreExportDir string) error {
+	if len(data.SwingStoreExportData) == 0 {
+		return nil
+	}
+
+	artifactFiles, err := keeper.ListSwingStoreExportDirectoryArtifacts(swingStoreExportDir)
+	if err != nil {
+		return fmt.Errorf("swing-store export directory %s: %w", swingStoreExportDir, err)
+	}
+
+	required, err := keeper.RequiredSwingStoreArtifacts(
+		agoric.NewSwingStoreExportDataEntriesReader(data.SwingStoreExportData),
+		keeper.SwingStoreArtifactModeReplay,
+	)
+	if err != nil {
+		return err
+	}
+	missing := []string{}
+	for _, name := range required {
+		filePath, ok := artifactFiles[name]
+		if !ok {
+			missing = append(missing, name)
+		} else if _, err := os.Stat(filePath); err != nil {
+			return fmt.Errorf("swing-store artifact %s: %w", name, err)
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("missing swing-store artifacts in %s: %s", swingStoreExportDir, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// openGenesisSwingStoreArtifacts returns a function reading the next
+// swing-store artifact of the genesis, according to its artifacts mode.
+func openGenesisSwingStoreArtifacts(data *types.GenesisState, swingStoreExportDir string) (func() (types.SwingStoreArtifact, error), error) {
+	switch data.SwingStoreArtifactsMode {
+	case "", SwingStoreArtifactsModeDirectory:
+		artifactProvider, err := keeper.OpenSwingStoreExportDirectory(swingStoreExportDir, 0)
+		if err != nil {
+			return nil, err
+		}
+		return artifactProvider.ReadNextArtifact, nil
+	case SwingStoreArtifactsModeReference, SwingStoreArtifactsModeInline:
+	default:
+		return nil, fmt.Errorf("invalid swing-store artifacts mode %q", data.SwingStoreArtifactsMode)
+	}
+
+	nextArtifact := 0
+	return func() (types.SwingStoreArtifact, error) {
+		if nextArtifact >= len(data.SwingStoreArtifacts) {
+			return types.SwingStoreArtifact{}, io.EOF
+		}
+		artifact := data.SwingStoreArtifacts[nextArtifact]
+		nextArtifact++
+		artifactData, err := readGenesisSwingStoreArtifact(swingStoreExportDir, artifact)
+		if err != nil {
+			return types.SwingStoreArtifact{}, err
+		}
+		return types.SwingStoreArtifact{Name: artifact.Name, Data: artifactData}, nil
+	}, nil
+}
+
 func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{
 		Params:               types.DefaultParams(),
@@ -39,7 +286,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 		return true
 	}
 
-	artifactProvider, err := keeper.OpenSwingStoreExportDirectory(swingStoreExportDir)
+	readNextArtifact, err := openGenesisSwingStoreArtifacts(data, swingStoreExportDir)
 	if err != nil {
 		panic(err)
 	}
@@ -49,6 +296,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	for _, entry := range swingStoreExportData {
 		swingStore.Set([]byte(entry.Key), []byte(entry.Value))
 	}
+	k.RecomputeSwingStoreExportDataStats(ctx)
 
 	snapshotHeight := uint64(ctx.BlockHeight())
 
@@ -61,7 +309,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 		keeper.SwingStoreExportProvider{
 			BlockHeight:         snapshotHeight,
 			GetExportDataReader: getExportDataReader,
-			ReadNextArtifact:    artifactProvider.ReadNextArtifact,
+			ReadNextArtifact:    readNextArtifact,
 		},
 		keeper.SwingStoreRestoreOptions{
 			ArtifactMode:   keeper.SwingStoreArtifactModeReplay,
@@ -75,7 +323,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	return false
 }
 
-func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string) *types.GenesisState {
+// ExportGenesis exports the (Cosmos-side) SwingSet state, including the
+// swing-store export data, as a GenesisState. The swing-store artifacts are
+// written to swingStoreExportDir or embedded in the GenesisState depending on
+// swingStoreArtifactsMode. In inline mode, the export fails if the total size
+// of the artifacts exceeds swingStoreInlineArtifactsMaxSize.
+func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, swingStoreArtifactsMode string, swingStoreInlineArtifactsMaxSize uint64) *types.GenesisState {
+	if !IsValidSwingStoreArtifactsMode(swingStoreArtifactsMode) {
+		panic(fmt.Errorf("invalid swing-store artifacts mode %q", swingStoreArtifactsMode))
+	}
+
 	gs := &types.GenesisState{
 		Params:               k.GetParams(ctx),
 		State:                k.GetState(ctx),
@@ -94,10 +351,16 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 
 	snapshotHeight := uint64(ctx.BlockHeight())
 
-	err := swingStoreExportsHandler.InitiateExport(
+	operation, err := swingStoreExportsHandler.QueueExport(
 		// The export will fail if the export of a historical height was requested
 		snapshotHeight,
-		swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, snapshotHeight: snapshotHeight},
+		swingStoreGenesisEventHandler{
+			exportDir:              swingStoreExportDir,
+			snapshotHeight:         snapshotHeight,
+			artifactsMode:          swingStoreArtifactsMode,
+			inlineArtifactsMaxSize: swingStoreInlineArtifactsMaxSize,
+			gs:                     gs,
+		},
 		// The export will fail if the swing-store does not contain all replay artifacts
 		keeper.SwingStoreExportOptions{
 			ArtifactMode:   keeper.SwingStoreArtifactModeReplay,
@@ -108,7 +371,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 		panic(err)
 	}
 
-	err = keeper.WaitUntilSwingStoreExportDone()
+	err = operation.WaitUntilDone()
 	if err != nil {
 		panic(err)
 	}
@@ -119,6 +382,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 type swingStoreGenesisEventHandler struct {
 	exportDir      string
 	snapshotHeight uint64
+	artifactsMode  string
+	// inlineArtifactsMaxSize is the maximum total size of the artifacts in inline mode
+	inlineArtifactsMaxSize uint64
+	// gs receives the listed artifacts in reference and inline modes
+	gs *types.GenesisState
 }
 
 func (eventHandler swingStoreGenesisEventHandler) OnExportStarted(height uint64, retrieveSwingStoreExport func() error) error {
@@ -130,6 +398,11 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 		return fmt.Errorf("snapshot block height (%d) doesn't match requested height (%d)", provider.BlockHeight, eventHandler.snapshotHeight)
 	}
 
+	switch eventHandler.artifactsMode {
+	case SwingStoreArtifactsModeReference, SwingStoreArtifactsModeInline:
+		return eventHandler.listArtifacts(provider)
+	}
+
 	artifactsProvider := keeper.SwingStoreExportProvider{
 		GetExportDataReader: func() (agoric.KVEntryReader, error) {
 			return nil, nil
@@ -139,3 +412,60 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 
 	return keeper.WriteSwingStoreExportToDirectory(artifactsProvider, eventHandler.exportDir)
 }
+
+// listArtifacts lists the exported artifacts in the genesis state. In
+// reference mode, the artifacts are also written to the export directory
+// using the content-addressed layout, and referenced by their path in it.
+func (eventHandler swingStoreGenesisEventHandler) listArtifacts(provider keeper.SwingStoreExportProvider) error {
+	gs := eventHandler.gs
+	gs.SwingStoreArtifactsMode = eventHandler.artifactsMode
+	gs.SwingStoreArtifacts = []*types.SwingStoreGenesisArtifact{}
+	inlineSize := uint64(0)
+
+	readNextArtifact := func() (types.SwingStoreArtifact, error) {
+		artifact, err := provider.ReadNextArtifact()
+		if err != nil || artifact.Name == keeper.UntrustedExportDataArtifactName {
+			return artifact, err
+		}
+
+		genesisArtifact := types.SwingStoreGenesisArtifact{
+			Name:   artifact.Name,
+			Sha256: genesisArtifactSha256(artifact.Data),
+		}
+		if eventHandler.artifactsMode == SwingStoreArtifactsModeReference {
+			genesisArtifact.URI = keeper.ContentAddressedArtifactPath(artifact.Data)
+		} else {
+			inlineSize += uint64(len(artifact.Data))
+			if inlineSize > eventHandler.inlineArtifactsMaxSize {
+				return artifact, fmt.Errorf(
+					"swing-store artifacts exceed the %s mode maximum size of %d bytes",
+					SwingStoreArtifactsModeInline, eventHandler.inlineArtifactsMaxSize,
+				)
+			}
+			genesisArtifact.Data = artifact.Data
+		}
+		gs.SwingStoreArtifacts = append(gs.SwingStoreArtifacts, &genesisArtifact)
+		return artifact, nil
+	}
+
+	if eventHandler.artifactsMode == SwingStoreArtifactsModeInline {
+		for {
+			_, err := readNextArtifact()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	artifactsProvider := keeper.SwingStoreExportProvider{
+		BlockHeight: provider.BlockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			return nil, nil
+		},
+		ReadNextArtifact: readNextArtifact,
+	}
+
+	return keeper.WriteSwingStoreExportToDirectoryWithLayout(artifactsProvider, eventHandler.exportDir, keeper.SwingStoreExportLayoutContentAddressed)
+}
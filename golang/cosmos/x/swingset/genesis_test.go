@@ -1,7 +1,14 @@
 package swingset
 
 import (
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
@@ -10,3 +17,190 @@ func TestDefaultGenesis(t *testing.T) {
 		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
 	}
 }
+
+var bundleData = []byte("bundle")
+
+func genesisWithArtifacts(mode string, artifacts ...*types.SwingStoreGenesisArtifact) *types.GenesisState {
+	gs := DefaultGenesisState()
+	gs.SwingStoreExportData = []*types.SwingStoreExportDataEntry{
+		{Key: "kv.foo", Value: "bar"},
+		{Key: "bundle.b1-123", Value: "b1-123"},
+	}
+	gs.SwingStoreArtifactsMode = mode
+	gs.SwingStoreArtifacts = artifacts
+	return gs
+}
+
+func TestValidateGenesisSwingStoreArtifacts(t *testing.T) {
+	hash := genesisArtifactSha256(bundleData)
+
+	for _, tc := range []struct {
+		name  string
+		gs    *types.GenesisState
+		valid bool
+	}{
+		{"directory", genesisWithArtifacts(""), true},
+		{"directory with artifacts", genesisWithArtifacts(SwingStoreArtifactsModeDirectory,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, URI: "b"}), false},
+		{"invalid mode", genesisWithArtifacts("foo"), false},
+		{"reference", genesisWithArtifacts(SwingStoreArtifactsModeReference,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, URI: "objects/" + hash}), true},
+		{"reference missing", genesisWithArtifacts(SwingStoreArtifactsModeReference), false},
+		{"reference absolute uri", genesisWithArtifacts(SwingStoreArtifactsModeReference,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, URI: "/etc/passwd"}), false},
+		{"reference escaping uri", genesisWithArtifacts(SwingStoreArtifactsModeReference,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, URI: "../b"}), false},
+		{"reference invalid hash", genesisWithArtifacts(SwingStoreArtifactsModeReference,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: "abc", URI: "b"}), false},
+		{"inline", genesisWithArtifacts(SwingStoreArtifactsModeInline,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, Data: bundleData}), true},
+		{"inline corrupt", genesisWithArtifacts(SwingStoreArtifactsModeInline,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, Data: []byte("other")}), false},
+		{"inline duplicate", genesisWithArtifacts(SwingStoreArtifactsModeInline,
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, Data: bundleData},
+			&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, Data: bundleData}), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGenesis(tc.gs)
+			if tc.valid && err != nil {
+				t.Errorf("unexpected error %v", err)
+			} else if !tc.valid && err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+func TestGenesisSwingStoreArtifactReferences(t *testing.T) {
+	exportDir := t.TempDir()
+	hash := genesisArtifactSha256(bundleData)
+	gs := genesisWithArtifacts(SwingStoreArtifactsModeReference,
+		&types.SwingStoreGenesisArtifact{Name: "bundle.b1-123", Sha256: hash, URI: "objects/" + hash})
+
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err == nil {
+		t.Fatal("expected missing file error")
+	}
+
+	filePath := filepath.Join(exportDir, "objects", hash)
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte("other"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err == nil {
+		t.Fatal("expected corrupt file error")
+	}
+
+	if err := os.WriteFile(filePath, bundleData, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err != nil {
+		t.Fatal(err)
+	}
+
+	readNextArtifact, err := openGenesisSwingStoreArtifacts(gs, exportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	artifact, err := readNextArtifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if artifact.Name != "bundle.b1-123" || string(artifact.Data) != string(bundleData) {
+		t.Errorf("unexpected artifact %v", artifact)
+	}
+	if _, err := readNextArtifact(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestGenesisSwingStoreExportDirectory(t *testing.T) {
+	exportDir := t.TempDir()
+	gs := genesisWithArtifacts(SwingStoreArtifactsModeDirectory)
+
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err == nil {
+		t.Fatal("expected missing manifest error")
+	}
+
+	writeExport := func(artifacts ...types.SwingStoreArtifact) {
+		t.Helper()
+		nextArtifact := 0
+		provider := keeper.SwingStoreExportProvider{
+			GetExportDataReader: func() (agoric.KVEntryReader, error) {
+				return nil, nil
+			},
+			ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+				if nextArtifact >= len(artifacts) {
+					return types.SwingStoreArtifact{}, io.EOF
+				}
+				nextArtifact++
+				return artifacts[nextArtifact-1], nil
+			},
+		}
+		if err := keeper.WriteSwingStoreExportToDirectory(provider, exportDir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeExport(types.SwingStoreArtifact{Name: "bundle.b2-456", Data: bundleData})
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err == nil {
+		t.Fatal("expected missing artifact error")
+	}
+
+	writeExport(types.SwingStoreArtifact{Name: "bundle.b1-123", Data: bundleData})
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err != nil {
+		t.Fatal(err)
+	}
+
+	artifactFiles, err := keeper.ListSwingStoreExportDirectoryArtifacts(exportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(artifactFiles["bundle.b1-123"]); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateGenesisSwingStoreArtifactFiles(gs, exportDir); err == nil {
+		t.Fatal("expected missing file error")
+	}
+}
+
+func TestGenesisSwingStoreInlineArtifactsMaxSize(t *testing.T) {
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "bundle.b1-123", Data: bundleData},
+		{Name: "bundle.b2-456", Data: bundleData},
+	}
+	listArtifacts := func(maxSize uint64) (*types.GenesisState, error) {
+		nextArtifact := 0
+		provider := keeper.SwingStoreExportProvider{
+			BlockHeight: 10,
+			ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+				if nextArtifact >= len(artifacts) {
+					return types.SwingStoreArtifact{}, io.EOF
+				}
+				nextArtifact++
+				return artifacts[nextArtifact-1], nil
+			},
+		}
+		gs := DefaultGenesisState()
+		eventHandler := swingStoreGenesisEventHandler{
+			snapshotHeight:         10,
+			artifactsMode:          SwingStoreArtifactsModeInline,
+			inlineArtifactsMaxSize: maxSize,
+			gs:                     gs,
+		}
+		return gs, eventHandler.OnExportRetrieved(provider)
+	}
+
+	gs, err := listArtifacts(uint64(2 * len(bundleData)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gs.SwingStoreArtifacts) != 2 {
+		t.Errorf("expected 2 inline artifacts, got %d", len(gs.SwingStoreArtifacts))
+	}
+
+	if _, err := listArtifacts(uint64(2*len(bundleData) - 1)); err == nil {
+		t.Error("expected inline artifacts max size error")
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	snapshots "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/klauspost/compress/zstd"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -22,8 +24,23 @@ import (
 var _ snapshots.ExtensionSnapshotter = &ExtensionSnapshotter{}
 var _ SwingStoreExportEventHandler = &ExtensionSnapshotter{}
 
-// SnapshotFormat 1 defines all extension payloads to be SwingStoreArtifact proto messages
-const SnapshotFormat = 1
+// SnapshotFormatArtifacts 1 defines all extension payloads to be
+// SwingStoreArtifact proto messages.
+const SnapshotFormatArtifacts = 1
+
+// SnapshotFormatChunks 2 defines all extension payloads to be
+// SwingStoreArtifactChunk proto messages. Each artifact is split into chunks
+// of at most snapshotArtifactChunkSize uncompressed bytes, written in order.
+// The first chunk of an artifact carries the size and hash of the artifact,
+// which are verified once all its chunks have been restored.
+const SnapshotFormatChunks = 2
+
+// SnapshotFormat is the format used when creating snapshots.
+const SnapshotFormat = SnapshotFormatChunks
+
+// snapshotArtifactChunkSize is the maximum size of the uncompressed data of a
+// SnapshotFormatChunks payload.
+const snapshotArtifactChunkSize = 4 << 20
 
 // snapshotDetails describes an in-progress state-sync snapshot
 type snapshotDetails struct {
@@ -69,6 +86,10 @@ type ExtensionSnapshotter struct {
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader
 	logger                                  log.Logger
 	activeSnapshot                          *snapshotDetails
+	// pendingExport is the swing-store export operation of the last initiated
+	// snapshot. It is only accessed by the main goroutine.
+	pendingExport *SwingStoreExportOperation
+	progress      *snapshotProgressTracker
 }
 
 // NewExtensionSnapshotter creates a new swingset ExtensionSnapshotter
@@ -84,6 +105,7 @@ func NewExtensionSnapshotter(
 		swingStoreExportsHandler:                swingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader: getSwingStoreExportDataShadowCopyReader,
 		activeSnapshot:                          nil,
+		progress:                                newSnapshotProgressTracker(),
 	}
 }
 
@@ -105,12 +127,13 @@ func (snapshotter *ExtensionSnapshotter) SnapshotFormat() uint32 {
 // restore from.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{SnapshotFormat}
+	return []uint32{SnapshotFormatArtifacts, SnapshotFormatChunks}
 }
 
 // InitiateSnapshot initiates a snapshot for the given block height.
-// If a snapshot is already in progress, or if no snapshot manager is
-// configured, this will fail.
+// If a snapshot is already in progress or queued, or if no snapshot manager is
+// configured, this will fail. Other swing-store operations in progress do not
+// prevent a snapshot, which is queued after them.
 //
 // The snapshot operation is performed in a goroutine.
 // Use WaitUntilSwingStoreExportStarted to synchronize commit boundaries.
@@ -122,20 +145,28 @@ func (snapshotter *ExtensionSnapshotter) InitiateSnapshot(height int64) error {
 		return fmt.Errorf("block height must not be negative or 0")
 	}
 
+	if operation := snapshotter.pendingExport; operation != nil && operation.QueuePosition() >= 0 {
+		return fmt.Errorf("snapshot already in progress for height %d", operation.details.blockHeight)
+	}
+
 	blockHeight := uint64(height)
 
-	return snapshotter.swingStoreExportsHandler.InitiateExport(blockHeight, snapshotter, SwingStoreExportOptions{
+	operation, err := snapshotter.swingStoreExportsHandler.QueueExport(blockHeight, snapshotter, SwingStoreExportOptions{
 		ArtifactMode:   SwingStoreArtifactModeReplay,
 		ExportDataMode: SwingStoreExportDataModeSkip,
 	})
+	if err != nil {
+		return err
+	}
+	snapshotter.pendingExport = operation
+	return nil
 }
 
 // OnExportStarted performs the actual cosmos state-sync app snapshot.
 // The cosmos implementation will ultimately call SnapshotExtension, which can
 // retrieve and process the SwingStore artifacts.
 // This method is invoked by the SwingStoreExportsHandler in a goroutine
-// started by InitiateExport, only if no other SwingStore export operation is
-// already in progress.
+// started by QueueExport, once no other SwingStore operation is in progress.
 //
 // Implements SwingStoreExportEventHandler
 func (snapshotter *ExtensionSnapshotter) OnExportStarted(blockHeight uint64, retrieveExport func() error) error {
@@ -202,7 +233,7 @@ func (snapshotter *ExtensionSnapshotter) SnapshotExtension(blockHeight uint64, p
 // manager goroutine synchronized with SwingStoreExportsHandler's own goroutine.
 //
 // Implements SwingStoreExportEventHandler
-func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreExportProvider) error {
+func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreExportProvider) (err error) {
 	snapshotDetails := snapshotter.activeSnapshot
 	if snapshotDetails == nil || snapshotDetails.payloadWriter == nil {
 		// shouldn't happen, but return an error if it does
@@ -213,17 +244,29 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 		return fmt.Errorf("SwingStore export received for unexpected block height %d (app snapshot height is %d)", provider.BlockHeight, snapshotDetails.blockHeight)
 	}
 
-	writeArtifactToPayload := func(artifact types.SwingStoreArtifact) error {
-		payloadBytes, err := artifact.Marshal()
-		if err != nil {
-			return err
-		}
+	snapshotter.progress.start(SnapshotOperationCreate, snapshotDetails.blockHeight)
+	defer func() {
+		snapshotter.progress.finish(snapshotDetails.logger, err)
+	}()
+
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return err
+	}
+	defer encoder.Close()
+
+	payloadWriter := func(payload []byte) error {
+		snapshotter.progress.addPayloadBytes(len(payload))
+		return snapshotDetails.payloadWriter(payload)
+	}
 
-		err = snapshotDetails.payloadWriter(payloadBytes)
+	writeArtifactToPayload := func(artifact types.SwingStoreArtifact) error {
+		snapshotter.progress.beginArtifact(artifact.Name)
+		err := writeArtifactChunks(artifact, snapshotArtifactChunkSize, encoder, payloadWriter)
 		if err != nil {
 			return err
 		}
-
+		snapshotter.progress.endArtifact(artifact.Name, len(artifact.Data))
 		return nil
 	}
 
@@ -273,8 +316,8 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 // RestoreExtension restores an extension state snapshot,
 // the payload reader returns io.EOF when it reaches the extension boundaries.
 // Implements ExtensionSnapshotter
-func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
-	if format != SnapshotFormat {
+func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) (err error) {
+	if format != SnapshotFormatArtifacts && format != SnapshotFormatChunks {
 		return snapshots.ErrUnknownFormat
 	}
 
@@ -283,6 +326,22 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 	}
 	height := int64(blockHeight)
 
+	if operation := snapshotter.pendingExport; operation != nil && operation.QueuePosition() >= 0 {
+		return fmt.Errorf("snapshot in progress for height %d", operation.details.blockHeight)
+	}
+
+	snapshotter.progress.start(SnapshotOperationRestore, blockHeight)
+	defer func() {
+		snapshotter.progress.finish(snapshotter.logger.With("height", blockHeight), err)
+	}()
+
+	extensionPayloadReader := payloadReader
+	payloadReader = func() ([]byte, error) {
+		payload, err := extensionPayloadReader()
+		snapshotter.progress.addPayloadBytes(len(payload))
+		return payload, err
+	}
+
 	// Retrieve the SwingStore "ExportData" from the verified vstorage data.
 	// At this point the content of the cosmos DB has been verified against the
 	// AppHash, which means the SwingStore data it contains can be used as the
@@ -299,11 +358,141 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 		}
 
 		err = artifact.Unmarshal(payloadBytes)
+		if err == nil {
+			snapshotter.progress.beginArtifact(artifact.Name)
+		}
+		return artifact, err
+	}
+
+	if format == SnapshotFormatChunks {
+		decoder, err := newArtifactChunkDecoder()
+		if err != nil {
+			return err
+		}
+		defer decoder.Close()
+
+		readNextArtifact = func() (types.SwingStoreArtifact, error) {
+			return readArtifactFromChunks(payloadReader, decoder, snapshotter.progress.beginArtifact)
+		}
+	}
+
+	readAndTrackNextArtifact := func() (types.SwingStoreArtifact, error) {
+		artifact, err := readNextArtifact()
+		if err == nil {
+			snapshotter.progress.endArtifact(artifact.Name, len(artifact.Data))
+		}
 		return artifact, err
 	}
 
 	return snapshotter.swingStoreExportsHandler.RestoreExport(
-		SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact},
+		SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readAndTrackNextArtifact},
 		SwingStoreRestoreOptions{ArtifactMode: SwingStoreArtifactModeReplay, ExportDataMode: SwingStoreExportDataModeAll},
 	)
 }
+
+// writeArtifactChunks splits an artifact into chunks of at most chunkSize
+// uncompressed bytes, and writes each compressed chunk as a payload. An empty
+// artifact is written as a single empty chunk.
+func writeArtifactChunks(artifact types.SwingStoreArtifact, chunkSize int, encoder *zstd.Encoder, payloadWriter snapshots.ExtensionPayloadWriter) error {
+	hash := sha256.Sum256(artifact.Data)
+
+	offset := 0
+	for {
+		end := offset + chunkSize
+		if end > len(artifact.Data) {
+			end = len(artifact.Data)
+		}
+
+		chunk := types.SwingStoreArtifactChunk{
+			Name:           artifact.Name,
+			Offset:         uint64(offset),
+			CompressedData: encoder.EncodeAll(artifact.Data[offset:end], nil),
+		}
+		if offset == 0 {
+			chunk.ArtifactSize = uint64(len(artifact.Data))
+			chunk.Sha256 = hash[:]
+		}
+
+		payloadBytes, err := chunk.Marshal()
+		if err != nil {
+			return err
+		}
+		err = payloadWriter(payloadBytes)
+		if err != nil {
+			return err
+		}
+
+		offset = end
+		if offset == len(artifact.Data) {
+			return nil
+		}
+	}
+}
+
+// newArtifactChunkDecoder returns a decoder for the chunks written by
+// writeArtifactChunks, refusing to decompress a chunk beyond
+// snapshotArtifactChunkSize bytes.
+func newArtifactChunkDecoder() (*zstd.Decoder, error) {
+	return zstd.NewReader(nil, zstd.WithDecoderMaxMemory(snapshotArtifactChunkSize))
+}
+
+// readArtifactFromChunks reads the chunks of the next artifact from the
+// payloads, and verifies the reassembled artifact against the size and hash
+// carried by its first chunk. If not nil, beginArtifact is called with the
+// artifact name once its first chunk is read. It returns io.EOF if there are
+// no more payloads.
+func readArtifactFromChunks(payloadReader snapshots.ExtensionPayloadReader, decoder *zstd.Decoder, beginArtifact func(name string)) (types.SwingStoreArtifact, error) {
+	var artifact types.SwingStoreArtifact
+	var artifactSize uint64
+	var expectedHash []byte
+
+	for first := true; ; first = false {
+		payloadBytes, err := payloadReader()
+		if err == io.EOF && !first {
+			return artifact, fmt.Errorf("snapshot ended before the end of artifact %s", artifact.Name)
+		} else if err != nil {
+			return artifact, err
+		}
+
+		var chunk types.SwingStoreArtifactChunk
+		err = chunk.Unmarshal(payloadBytes)
+		if err != nil {
+			return artifact, err
+		}
+
+		if first {
+			if chunk.Offset != 0 {
+				return artifact, fmt.Errorf("missing first chunk of artifact %s", chunk.Name)
+			}
+			if len(chunk.Sha256) != sha256.Size {
+				return artifact, fmt.Errorf("invalid hash for artifact %s", chunk.Name)
+			}
+			artifact.Name = chunk.Name
+			artifactSize = chunk.ArtifactSize
+			expectedHash = chunk.Sha256
+			if beginArtifact != nil {
+				beginArtifact(artifact.Name)
+			}
+		} else if chunk.Name != artifact.Name || chunk.Offset != uint64(len(artifact.Data)) {
+			return artifact, fmt.Errorf("unexpected chunk of artifact %s at offset %d, expected artifact %s at offset %d", chunk.Name, chunk.Offset, artifact.Name, len(artifact.Data))
+		}
+
+		// Decode each chunk on its own, since the decoder memory limit also counts
+		// any data already in the destination buffer.
+		data, err := decoder.DecodeAll(chunk.CompressedData, nil)
+		if err != nil {
+			return artifact, fmt.Errorf("failed to decompress chunk of artifact %s at offset %d: %w", artifact.Name, chunk.Offset, err)
+		}
+		artifact.Data = append(artifact.Data, data...)
+
+		if uint64(len(artifact.Data)) > artifactSize {
+			return artifact, fmt.Errorf("artifact %s exceeds its size of %d bytes", artifact.Name, artifactSize)
+		} else if uint64(len(artifact.Data)) == artifactSize {
+			hash := sha256.Sum256(artifact.Data)
+			if !bytes.Equal(hash[:], expectedHash) {
+				return artifact, fmt.Errorf("artifact %s does not match its hash", artifact.Name)
+			}
+			return artifact, nil
+		}
+	}
+}
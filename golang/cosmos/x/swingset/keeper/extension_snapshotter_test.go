@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"io"
 	"testing"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/klauspost/compress/zstd"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -13,6 +16,7 @@ func newTestExtensionSnapshotter() *ExtensionSnapshotter {
 		isConfigured:             func() bool { return true },
 		logger:                   logger,
 		swingStoreExportsHandler: newTestSwingStoreExportsHandler(),
+		progress:                 newSnapshotProgressTracker(),
 	}
 }
 
@@ -26,7 +30,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -46,7 +50,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	}
 
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -55,7 +59,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,7 +84,7 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// First run through app.Commit()
-	err := WaitUntilSwingStoreExportStarted()
+	err := extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -90,15 +94,156 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// Second run through app.Commit() - should return right away
-	err = WaitUntilSwingStoreExportStarted()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// close the signaling channel to let goroutine exit
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestArtifactChunks(t *testing.T) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer encoder.Close()
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer decoder.Close()
+
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "transcript.v1.0.2", Data: []byte("item1\nitem2\n")},
+		{Name: "empty", Data: []byte{}},
+		{Name: "snapshot.v1.2", Data: []byte("abc")},
+	}
+
+	writePayloads := func(artifacts []types.SwingStoreArtifact) [][]byte {
+		payloads := [][]byte{}
+		for _, artifact := range artifacts {
+			err := writeArtifactChunks(artifact, 5, encoder, func(payload []byte) error {
+				payloads = append(payloads, payload)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return payloads
+	}
+	newPayloadReader := func(payloads [][]byte) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		}
+	}
+
+	payloads := writePayloads(artifacts)
+	// 3 chunks for the transcript, 1 for the empty artifact, 1 for the snapshot
+	if len(payloads) != 5 {
+		t.Fatalf("got %d payloads, wanted 5", len(payloads))
+	}
+
+	payloadReader := newPayloadReader(payloads)
+	for _, expected := range artifacts {
+		begun := ""
+		artifact, err := readArtifactFromChunks(payloadReader, decoder, func(name string) { begun = name })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if begun != expected.Name {
+			t.Errorf("got begun artifact %q, wanted %q", begun, expected.Name)
+		}
+		if artifact.Name != expected.Name || !bytes.Equal(artifact.Data, expected.Data) {
+			t.Errorf("got artifact %s %q, wanted %s %q", artifact.Name, artifact.Data, expected.Name, expected.Data)
+		}
+	}
+	if _, err := readArtifactFromChunks(payloadReader, decoder, nil); err != io.EOF {
+		t.Errorf("wanted EOF, got %v", err)
+	}
+
+	// A corrupted chunk fails the artifact hash verification
+	var chunk types.SwingStoreArtifactChunk
+	if err := chunk.Unmarshal(payloads[1]); err != nil {
+		t.Fatal(err)
+	}
+	chunk.CompressedData = encoder.EncodeAll([]byte("ITEM2"), nil)
+	corrupted, err := chunk.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = readArtifactFromChunks(newPayloadReader([][]byte{payloads[0], corrupted, payloads[2]}), decoder, nil)
+	if err == nil {
+		t.Error("wanted error for corrupted artifact")
+	}
+
+	// A missing chunk is detected before the end of the artifact
+	_, err = readArtifactFromChunks(newPayloadReader([][]byte{payloads[0], payloads[2]}), decoder, nil)
+	if err == nil {
+		t.Error("wanted error for missing chunk")
+	}
+
+	// A truncated snapshot is detected
+	_, err = readArtifactFromChunks(newPayloadReader(payloads[:2]), decoder, nil)
+	if err == nil {
+		t.Error("wanted error for truncated snapshot")
+	}
+}
+
+func TestArtifactChunksLargerThanChunkSize(t *testing.T) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer encoder.Close()
+	decoder, err := newArtifactChunkDecoder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer decoder.Close()
+
+	// Two and a half chunks of poorly compressible data.
+	data := make([]byte, 2*snapshotArtifactChunkSize+snapshotArtifactChunkSize/2)
+	for i := range data {
+		data[i] = byte(i*7919 + i/251)
+	}
+	artifact := types.SwingStoreArtifact{Name: "snapshot.v1.1", Data: data}
+
+	payloads := [][]byte{}
+	err = writeArtifactChunks(artifact, snapshotArtifactChunkSize, encoder, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) != 3 {
+		t.Fatalf("got %d payloads, wanted 3", len(payloads))
+	}
+
+	got, err := readArtifactFromChunks(func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}, decoder, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != artifact.Name || !bytes.Equal(got.Data, artifact.Data) {
+		t.Errorf("got artifact %s of %d bytes, wanted %s of %d bytes", got.Name, len(got.Data), artifact.Name, len(artifact.Data))
+	}
+}
@@ -61,3 +61,64 @@ func (k Querier) Mailbox(c context.Context, req *types.QueryMailboxRequest) (*ty
 		Value: value,
 	}, nil
 }
+
+func (k Querier) HighPrioritySenders(c context.Context, req *types.QueryHighPrioritySendersRequest) (*types.QueryHighPrioritySendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senders, pageRes, err := k.PaginateHighPrioritySenders(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHighPrioritySendersResponse{
+		Senders:    senders,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) SwingStoreExportData(c context.Context, req *types.QuerySwingStoreExportDataRequest) (*types.QuerySwingStoreExportDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries, pageRes, err := k.PaginateSwingStoreExportData(ctx, req.Prefix, req.KeysOnly, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySwingStoreExportDataResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) SwingStoreExportDataEntry(c context.Context, req *types.QuerySwingStoreExportDataEntryRequest) (*types.QuerySwingStoreExportDataEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	value, found := k.GetSwingStoreExportDataEntry(ctx, req.Key)
+	if !found {
+		return nil, status.Error(codes.NotFound, "export data entry not found")
+	}
+
+	return &types.QuerySwingStoreExportDataEntryResponse{
+		Value: value,
+	}, nil
+}
+
+func (k Querier) SwingStoreExportDataStats(c context.Context, req *types.QuerySwingStoreExportDataStatsRequest) (*types.QuerySwingStoreExportDataStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySwingStoreExportDataStatsResponse{
+		Stats: k.GetSwingStoreExportDataStats(ctx),
+	}, nil
+}
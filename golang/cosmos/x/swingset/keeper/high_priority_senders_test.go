@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestHighPrioritySenders(t *testing.T) {
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	keeper := Keeper{vstorageKeeper: vstoragekeeper.NewKeeper(vstorageStoreKey)}
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	if err := keeper.AddHighPrioritySender(ctx, "oracles", addr1); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.AddHighPrioritySender(ctx, "econ committee", addr1); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.AddHighPrioritySender(ctx, "oracles", addr2); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.AddHighPrioritySender(ctx, "oracles", addr1); err == nil {
+		t.Error("adding an existing sender should fail")
+	}
+
+	got := keeper.GetHighPrioritySenderNamespaces(ctx, addr1)
+	if want := []string{"econ_committee", "oracles"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got namespaces %q, want %q", got, want)
+	}
+	if ok, _ := keeper.IsHighPriorityAddress(ctx, addr2); !ok {
+		t.Errorf("%s should be a high priority address", addr2)
+	}
+
+	senders, _, err := keeper.PaginateHighPrioritySenders(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSenders := []types.HighPrioritySender{
+		{Address: addr1.String(), Namespaces: []string{"econ_committee", "oracles"}},
+		{Address: addr2.String(), Namespaces: []string{"oracles"}},
+	}
+	if !reflect.DeepEqual(senders, wantSenders) {
+		t.Errorf("got senders %v, want %v", senders, wantSenders)
+	}
+
+	if err := keeper.RemoveHighPrioritySender(ctx, "oracles", addr2); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.RemoveHighPrioritySender(ctx, "oracles", addr2); err == nil {
+		t.Error("removing a missing sender should fail")
+	}
+	if ok, _ := keeper.IsHighPriorityAddress(ctx, addr2); ok {
+		t.Errorf("%s should no longer be a high priority address", addr2)
+	}
+}
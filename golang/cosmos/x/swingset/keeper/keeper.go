@@ -6,6 +6,8 @@ import (
 	"fmt"
 	stdlog "log"
 	"math"
+	"sort"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -47,8 +50,10 @@ const (
 )
 
 const (
-	stateKey            = "state"
-	swingStoreKeyPrefix = "swingStore."
+	stateKey                          = "state"
+	swingStoreKeyPrefix               = "swingStore."
+	swingStoreExportDataStatsKey      = "swingStoreExportDataStats"
+	swingStoreExportDataBlockUsageKey = "swingStoreExportDataBlockUsage"
 )
 
 // Contextual information about the message source of an action on an inbound queue.
@@ -64,6 +69,10 @@ type actionContext struct {
 	// result from a cosmos transaction, a number should be chosen to make the
 	// actionContext unique. (for example a counter per block and source module).
 	MsgIdx int `json:"msgIdx"`
+	// The index of the action within a batch message such as
+	// MsgWalletActionBatch, whose actions share the same TxHash and MsgIdx.
+	// Omitted for messages which enqueue a single action.
+	BatchIdx *int `json:"batchIdx,omitempty"`
 }
 type inboundQueueRecord struct {
 	Action  vm.Jsonable   `json:"action"`
@@ -131,10 +140,16 @@ func populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
 //
 // The inbound queue's format is documented by `makeChainQueue` in
 // `packages/cosmic-swingset/src/helpers/make-queue.js`.
-func (k Keeper) pushAction(ctx sdk.Context, inboundQueuePath string, action vm.Action) error {
+func (k Keeper) pushAction(ctx sdk.Context, inboundQueuePath string, action vm.Action) (types.ActionContext, error) {
+	return k.pushBatchAction(ctx, inboundQueuePath, action, nil)
+}
+
+// pushBatchAction is like pushAction, but records the index of the action
+// within its batch (if not nil) in the inbound queue record's context.
+func (k Keeper) pushBatchAction(ctx sdk.Context, inboundQueuePath string, action vm.Action, batchIdx *int) (types.ActionContext, error) {
 	action, err := populateAction(ctx, action)
 	if err != nil {
-		return err
+		return types.ActionContext{}, err
 	}
 	txHash, txHashOk := ctx.Context().Value(baseapp.TxHashContextKey).(string)
 	if !txHashOk {
@@ -144,23 +159,39 @@ func (k Keeper) pushAction(ctx sdk.Context, inboundQueuePath string, action vm.A
 	if !txHashOk || !msgIdxOk {
 		stdlog.Printf("error while extracting context for action %q\n", action)
 	}
-	record := inboundQueueRecord{Action: action, Context: actionContext{BlockHeight: ctx.BlockHeight(), TxHash: txHash, MsgIdx: msgIdx}}
+	record := inboundQueueRecord{Action: action, Context: actionContext{BlockHeight: ctx.BlockHeight(), TxHash: txHash, MsgIdx: msgIdx, BatchIdx: batchIdx}}
 	bz, err := json.Marshal(record)
 	if err != nil {
-		return err
+		return types.ActionContext{}, err
+	}
+
+	index, err := k.vstorageKeeper.PushQueueItem(ctx, inboundQueuePath, string(bz))
+	if err != nil {
+		return types.ActionContext{}, err
+	}
+	if !index.IsUint64() {
+		return types.ActionContext{}, fmt.Errorf("%s index out of range: %s", inboundQueuePath, index)
 	}
 
-	return k.vstorageKeeper.PushQueueItem(ctx, inboundQueuePath, string(bz))
+	return types.ActionContext{
+		Queue:       inboundQueuePath,
+		Index:       index.Uint64(),
+		BlockHeight: record.Context.BlockHeight,
+		TxHash:      record.Context.TxHash,
+		MsgIdx:      int64(record.Context.MsgIdx),
+	}, nil
 }
 
 // PushAction appends an action to the controller's actionQueue.
 func (k Keeper) PushAction(ctx sdk.Context, action vm.Action) error {
-	return k.pushAction(ctx, StoragePathActionQueue, action)
+	_, err := k.pushAction(ctx, StoragePathActionQueue, action)
+	return err
 }
 
 // PushAction appends an action to the controller's highPriorityQueue.
 func (k Keeper) PushHighPriorityAction(ctx sdk.Context, action vm.Action) error {
-	return k.pushAction(ctx, StoragePathHighPriorityQueue, action)
+	_, err := k.pushAction(ctx, StoragePathHighPriorityQueue, action)
+	return err
 }
 
 func (k Keeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
@@ -168,6 +199,109 @@ func (k Keeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (boo
 	return k.vstorageKeeper.HasEntry(ctx, path), nil
 }
 
+// parseSenderNamespaces decodes the comma-separated namespaces stored for a
+// high priority sender by the JS priority senders manager.
+func parseSenderNamespaces(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// GetHighPrioritySenderNamespaces returns the sorted namespaces which have
+// granted high priority to the address.
+func (k Keeper) GetHighPrioritySenderNamespaces(ctx sdk.Context, addr sdk.AccAddress) []string {
+	path := StoragePathHighPrioritySenders + "." + addr.String()
+	return parseSenderNamespaces(k.vstorageKeeper.GetEntry(ctx, path).StringValue())
+}
+
+// setHighPrioritySenderNamespaces stores the namespaces of a high priority
+// sender in the format used by `makePrioritySendersManager` in
+// packages/internal/src/priority-senders.js, removing the sender if there are
+// none left.
+func (k Keeper) setHighPrioritySenderNamespaces(ctx sdk.Context, addr sdk.AccAddress, namespaces []string) {
+	path := StoragePathHighPrioritySenders + "." + addr.String()
+	entry := agoric.NewKVEntryWithNoValue(path)
+	if len(namespaces) > 0 {
+		sort.Strings(namespaces)
+		entry = agoric.NewKVEntry(path, strings.Join(namespaces, ","))
+	}
+	k.vstorageKeeper.SetStorageAndNotify(ctx, entry)
+}
+
+// AddHighPrioritySender grants high priority to the address on behalf of the
+// namespace, returning an error if the namespace already did so.
+//
+// The JS priority senders manager reads the namespaces of an address back
+// from vstorage before changing them, so it takes changes made here into
+// account.
+func (k Keeper) AddHighPrioritySender(ctx sdk.Context, namespace string, addr sdk.AccAddress) error {
+	namespace, err := types.NormalizeSenderNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	namespaces := k.GetHighPrioritySenderNamespaces(ctx, addr)
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return fmt.Errorf("namespace %q already has address %q", namespace, addr)
+		}
+	}
+	namespaces = append(namespaces, namespace)
+	k.setHighPrioritySenderNamespaces(ctx, addr, namespaces)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventHighPrioritySenderChanged{
+		Address:    addr.String(),
+		Namespace:  namespace,
+		Added:      true,
+		Namespaces: namespaces,
+	})
+}
+
+// RemoveHighPrioritySender revokes the high priority granted to the address
+// by the namespace, returning an error if the namespace had not done so.
+func (k Keeper) RemoveHighPrioritySender(ctx sdk.Context, namespace string, addr sdk.AccAddress) error {
+	namespace, err := types.NormalizeSenderNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	namespaces := k.GetHighPrioritySenderNamespaces(ctx, addr)
+	remaining := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		if ns != namespace {
+			remaining = append(remaining, ns)
+		}
+	}
+	if len(remaining) == len(namespaces) {
+		return fmt.Errorf("namespace %q does not have address %q", namespace, addr)
+	}
+	k.setHighPrioritySenderNamespaces(ctx, addr, remaining)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventHighPrioritySenderChanged{
+		Address:    addr.String(),
+		Namespace:  namespace,
+		Added:      false,
+		Namespaces: remaining,
+	})
+}
+
+// PaginateHighPrioritySenders returns the high priority senders selected by
+// pageReq, in lexicographic order of their address.
+func (k Keeper) PaginateHighPrioritySenders(ctx sdk.Context, pageReq *query.PageRequest) ([]types.HighPrioritySender, *query.PageResponse, error) {
+	senders := []types.HighPrioritySender{}
+	pageRes, err := k.vstorageKeeper.PaginateChildren(ctx, StoragePathHighPrioritySenders, pageReq, func(entry agoric.KVEntry) error {
+		path := entry.Key()
+		senders = append(senders, types.HighPrioritySender{
+			Address:    path[strings.LastIndex(path, ".")+1:],
+			Namespaces: parseSenderNamespaces(entry.StringValue()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return senders, pageRes, nil
+}
+
 // GetSmartWalletState returns the provision state of the smart wallet for the account address
 func (k Keeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) types.SmartWalletState {
 	// walletStoragePath is path of `walletStorageNode` constructed in
@@ -214,7 +348,7 @@ func (k Keeper) UpdateQueueAllowed(ctx sdk.Context) error {
 	if !found {
 		return errors.New("could not find max inboundQueue size in params")
 	}
-	inboundMempoolQueueMax := inboundQueueMax / 2
+	inboundMempoolQueueMax := int32(params.InboundMempoolQueueRatio.MulInt64(int64(inboundQueueMax)).TruncateInt64())
 
 	inboundQueueSize, err := k.InboundQueueLength(ctx)
 	if err != nil {
@@ -20,9 +20,27 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return m.MigrateParams(ctx)
 }
 
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.MigrateParams(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4, adding the export data block
+// budget param and the stats of the swing-store export data.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	err := m.MigrateParams(ctx)
+	if err != nil {
+		return err
+	}
+	m.keeper.RecomputeSwingStoreExportDataStats(ctx)
+	return nil
+}
+
 // MigrateParams migrates params by setting new params to their default value
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
-	params := m.keeper.GetParams(ctx)
+	// Params added since the last migration are not yet in the store.
+	var params types.Params
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
 	newParams, err := types.UpdateParams(params)
 	if err != nil {
 		return err
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateParams(t *testing.T) {
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	amino := codec.NewLegacyAmino()
+	cdc := codec.NewProtoCodec(nil)
+	paramSpace := paramstypes.NewSubspace(cdc, amino, paramsStoreKey, paramsTStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	keeper := Keeper{paramSpace: paramSpace}
+
+	// Simulate params stored before the inbound admission params existed.
+	oldParams := types.DefaultParams()
+	oldParams.QueueMax = []types.QueueSize{types.NewQueueSize(types.QueueInbound, 42)}
+	paramSpace.Set(ctx, types.ParamStoreKeyBeansPerUnit, oldParams.BeansPerUnit)
+	paramSpace.Set(ctx, types.ParamStoreKeyFeeUnitPrice, oldParams.FeeUnitPrice)
+	paramSpace.Set(ctx, types.ParamStoreKeyBootstrapVatConfig, oldParams.BootstrapVatConfig)
+	paramSpace.Set(ctx, types.ParamStoreKeyPowerFlagFees, oldParams.PowerFlagFees)
+	paramSpace.Set(ctx, types.ParamStoreKeyQueueMax, oldParams.QueueMax)
+
+	err = NewMigrator(keeper).Migrate2to3(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := keeper.GetParams(ctx)
+	if err := got.ValidateBasic(); err != nil {
+		t.Errorf("migrated params are invalid: %v", err)
+	}
+	if got.MaxInboundPerTx != types.DefaultMaxInboundPerTx {
+		t.Errorf("got max inbound per tx %d, want %d", got.MaxInboundPerTx, types.DefaultMaxInboundPerTx)
+	}
+	if !got.InboundMempoolQueueRatio.Equal(types.DefaultInboundMempoolQueueRatio) {
+		t.Errorf("got inbound mempool queue ratio %s, want %s", got.InboundMempoolQueueRatio, types.DefaultInboundMempoolQueueRatio)
+	}
+	if size, _ := types.QueueSizeEntry(got.QueueMax, types.QueueInbound); size != 42 {
+		t.Errorf("got inbound queue max %d, want existing value 42", size)
+	}
+}
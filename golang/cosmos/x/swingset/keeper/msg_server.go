@@ -27,16 +27,23 @@ type deliverInboundAction struct {
 	Ack             uint64          `json:"ack"`
 }
 
-func (keeper msgServer) routeAction(ctx sdk.Context, msg vm.ControllerAdmissionMsg, action vm.Action) error {
+// routeAction places the action on the inbound queue appropriate for the
+// message's priority, returning where it was enqueued.
+func (keeper msgServer) routeAction(ctx sdk.Context, msg vm.ControllerAdmissionMsg, action vm.Action) (types.ActionContext, error) {
+	return keeper.routeBatchAction(ctx, msg, action, nil)
+}
+
+// routeBatchAction is like routeAction, for one of the actions of a batch.
+func (keeper msgServer) routeBatchAction(ctx sdk.Context, msg vm.ControllerAdmissionMsg, action vm.Action, batchIdx *int) (types.ActionContext, error) {
 	isHighPriority, err := msg.IsHighPriority(ctx, keeper)
 	if err != nil {
-		return err
+		return types.ActionContext{}, err
 	}
 
 	if isHighPriority {
-		return keeper.PushHighPriorityAction(ctx, action)
+		return keeper.pushBatchAction(ctx, StoragePathHighPriorityQueue, action, batchIdx)
 	} else {
-		return keeper.PushAction(ctx, action)
+		return keeper.pushBatchAction(ctx, StoragePathActionQueue, action, batchIdx)
 	}
 }
 
@@ -54,7 +61,7 @@ func (keeper msgServer) DeliverInbound(goCtx context.Context, msg *types.MsgDeli
 		Ack:      msg.Ack,
 	}
 
-	err := keeper.routeAction(ctx, msg, action)
+	_, err := keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return nil, err
@@ -82,12 +89,21 @@ func (keeper msgServer) WalletAction(goCtx context.Context, msg *types.MsgWallet
 	}
 	// fmt.Fprintf(os.Stderr, "Context is %+v\n", ctx)
 
-	err = keeper.routeAction(ctx, msg, action)
+	actionContext, err := keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return nil, err
 	}
-	return &types.MsgWalletActionResponse{}, nil
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWalletAction{
+		Owner:         action.Owner,
+		Spend:         false,
+		ActionContext: actionContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWalletActionResponse{ActionContext: actionContext}, nil
 }
 
 type walletSpendAction struct {
@@ -109,11 +125,63 @@ func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgW
 		SpendAction: msg.SpendAction,
 	}
 	// fmt.Fprintf(os.Stderr, "Context is %+v\n", ctx)
-	err = keeper.routeAction(ctx, msg, action)
+	actionContext, err := keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWalletAction{
+		Owner:         action.Owner,
+		Spend:         true,
+		ActionContext: actionContext,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &types.MsgWalletSpendActionResponse{}, nil
+	return &types.MsgWalletSpendActionResponse{ActionContext: actionContext}, nil
+}
+
+func (keeper msgServer) WalletActionBatch(goCtx context.Context, msg *types.MsgWalletActionBatch) (*types.MsgWalletActionBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.provisionIfNeeded(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := msg.Owner.String()
+	actionContexts := make([]types.ActionContext, len(msg.Actions))
+	for i, batchAction := range msg.Actions {
+		batchIdx := i
+		var action vm.Action
+		if msg.Spend {
+			action = &walletSpendAction{
+				Owner:       owner,
+				SpendAction: batchAction,
+			}
+		} else {
+			action = &walletAction{
+				Owner:  owner,
+				Action: batchAction,
+			}
+		}
+
+		actionContexts[i], err = keeper.routeBatchAction(ctx, msg, action, &batchIdx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventWalletAction{
+			Owner:         owner,
+			Spend:         msg.Spend,
+			ActionContext: actionContexts[i],
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgWalletActionBatchResponse{ActionContexts: actionContexts}, nil
 }
 
 type provisionAction struct {
@@ -146,7 +214,7 @@ func (keeper msgServer) provisionIfNeeded(ctx sdk.Context, owner sdk.AccAddress)
 		AutoProvision: true,
 	}
 
-	err := keeper.routeAction(ctx, msg, action)
+	_, err := keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return err
@@ -174,7 +242,7 @@ func (keeper msgServer) Provision(goCtx context.Context, msg *types.MsgProvision
 		return nil, err
 	}
 
-	err = keeper.routeAction(ctx, msg, action)
+	_, err = keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return nil, err
@@ -199,7 +267,7 @@ func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInsta
 		MsgInstallBundle: msg,
 	}
 
-	err = keeper.routeAction(ctx, msg, action)
+	_, err = keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// makeMsgServerTestKit returns a msg server backed by an in-memory vstorage,
+// and a context for a message at msgIdx of the transaction txHash.
+func makeMsgServerTestKit(t *testing.T, txHash string, msgIdx int) (sdk.Context, Keeper, types.MsgServer) {
+	t.Helper()
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 5}, false, log.NewNopLogger())
+	goCtx := context.WithValue(ctx.Context(), baseapp.TxHashContextKey, txHash)
+	goCtx = context.WithValue(goCtx, baseapp.TxMsgIdxContextKey, msgIdx)
+	ctx = ctx.WithContext(goCtx)
+
+	keeper := Keeper{vstorageKeeper: vstoragekeeper.NewKeeper(vstorageStoreKey)}
+	return ctx, keeper, NewMsgServerImpl(keeper)
+}
+
+// testQueueRecord is the decoded form of an inboundQueueRecord.
+type testQueueRecord struct {
+	Action  map[string]interface{} `json:"action"`
+	Context struct {
+		BlockHeight int64  `json:"blockHeight"`
+		TxHash      string `json:"txHash"`
+		MsgIdx      int    `json:"msgIdx"`
+		BatchIdx    *int   `json:"batchIdx"`
+	} `json:"context"`
+}
+
+func getQueueRecord(t *testing.T, ctx sdk.Context, keeper Keeper, actionContext types.ActionContext) testQueueRecord {
+	t.Helper()
+	path := actionContext.Queue + "." + sdk.NewIntFromUint64(actionContext.Index).String()
+	entry := keeper.vstorageKeeper.GetEntry(ctx, path)
+	if !entry.HasValue() {
+		t.Fatalf("no inbound queue record at %s", path)
+	}
+	var record testQueueRecord
+	if err := json.Unmarshal([]byte(entry.StringValue()), &record); err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+// walletActionEvents returns the EventWalletAction events emitted in ctx.
+func walletActionEvents(t *testing.T, ctx sdk.Context) []*types.EventWalletAction {
+	t.Helper()
+	events := []*types.EventWalletAction{}
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&types.EventWalletAction{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, msg.(*types.EventWalletAction))
+	}
+	return events
+}
+
+func TestWalletAction(t *testing.T) {
+	ctx, keeper, msgServer := makeMsgServerTestKit(t, "ABCD", 1)
+	owner := sdk.AccAddress([]byte("owner_______________"))
+
+	res, err := msgServer.WalletAction(sdk.WrapSDKContext(ctx), &types.MsgWalletAction{Owner: owner, Action: `{"method":"foo"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The owner has no smart wallet, so a provision action is enqueued first.
+	want := types.ActionContext{Queue: StoragePathActionQueue, Index: 1, BlockHeight: 5, TxHash: "ABCD", MsgIdx: 1}
+	if res.ActionContext != want {
+		t.Errorf("got action context %v, want %v", res.ActionContext, want)
+	}
+
+	record := getQueueRecord(t, ctx, keeper, res.ActionContext)
+	if record.Action["type"] != "WALLET_ACTION" || record.Action["owner"] != owner.String() || record.Action["action"] != `{"method":"foo"}` {
+		t.Errorf("unexpected action %v", record.Action)
+	}
+	if record.Context.TxHash != "ABCD" || record.Context.MsgIdx != 1 || record.Context.BlockHeight != 5 || record.Context.BatchIdx != nil {
+		t.Errorf("unexpected context %+v", record.Context)
+	}
+
+	events := walletActionEvents(t, ctx)
+	if len(events) != 1 {
+		t.Fatalf("got %d wallet action events, want 1", len(events))
+	}
+	if events[0].Owner != owner.String() || events[0].Spend || events[0].ActionContext != want {
+		t.Errorf("unexpected event %v", events[0])
+	}
+}
+
+func TestWalletSpendAction(t *testing.T) {
+	ctx, keeper, msgServer := makeMsgServerTestKit(t, "ABCD", 0)
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	keeper.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathCustom+"."+WalletStoragePathSegment+"."+owner.String(), "wallet"))
+	if err := keeper.AddHighPrioritySender(ctx, "oracles", owner); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := msgServer.WalletSpendAction(sdk.WrapSDKContext(ctx), &types.MsgWalletSpendAction{Owner: owner, SpendAction: `{"method":"bar"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The owner is provisioned and a high priority sender.
+	want := types.ActionContext{Queue: StoragePathHighPriorityQueue, Index: 0, BlockHeight: 5, TxHash: "ABCD", MsgIdx: 0}
+	if res.ActionContext != want {
+		t.Errorf("got action context %v, want %v", res.ActionContext, want)
+	}
+
+	record := getQueueRecord(t, ctx, keeper, res.ActionContext)
+	if record.Action["type"] != "WALLET_SPEND_ACTION" || record.Action["owner"] != owner.String() || record.Action["spendAction"] != `{"method":"bar"}` {
+		t.Errorf("unexpected action %v", record.Action)
+	}
+
+	events := walletActionEvents(t, ctx)
+	if len(events) != 1 {
+		t.Fatalf("got %d wallet action events, want 1", len(events))
+	}
+	if events[0].Owner != owner.String() || !events[0].Spend || events[0].ActionContext != want {
+		t.Errorf("unexpected event %v", events[0])
+	}
+}
+
+func TestWalletActionBatch(t *testing.T) {
+	ctx, keeper, msgServer := makeMsgServerTestKit(t, "ABCD", 2)
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	keeper.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathCustom+"."+WalletStoragePathSegment+"."+owner.String(), "wallet"))
+
+	actions := []string{`{"method":"a"}`, `{"method":"b"}`, `{"method":"c"}`}
+	res, err := msgServer.WalletActionBatch(sdk.WrapSDKContext(ctx), &types.MsgWalletActionBatch{Owner: owner, Actions: actions})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.ActionContexts) != len(actions) {
+		t.Fatalf("got %d action contexts, want %d", len(res.ActionContexts), len(actions))
+	}
+	events := walletActionEvents(t, ctx)
+	if len(events) != len(actions) {
+		t.Fatalf("got %d wallet action events, want %d", len(events), len(actions))
+	}
+	for i, action := range actions {
+		want := types.ActionContext{Queue: StoragePathActionQueue, Index: uint64(i), BlockHeight: 5, TxHash: "ABCD", MsgIdx: 2}
+		if res.ActionContexts[i] != want {
+			t.Errorf("action %d: got action context %v, want %v", i, res.ActionContexts[i], want)
+		}
+
+		record := getQueueRecord(t, ctx, keeper, res.ActionContexts[i])
+		if record.Action["type"] != "WALLET_ACTION" || record.Action["owner"] != owner.String() || record.Action["action"] != action {
+			t.Errorf("action %d: unexpected action %v", i, record.Action)
+		}
+		if record.Context.TxHash != "ABCD" || record.Context.MsgIdx != 2 || record.Context.BatchIdx == nil || *record.Context.BatchIdx != i {
+			t.Errorf("action %d: unexpected context %+v", i, record.Context)
+		}
+
+		if events[i].Owner != owner.String() || events[i].Spend || events[i].ActionContext != want {
+			t.Errorf("action %d: unexpected event %v", i, events[i])
+		}
+	}
+}
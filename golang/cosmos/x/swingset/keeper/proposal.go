@@ -30,3 +30,27 @@ func (k Keeper) CoreEvalProposal(ctx sdk.Context, p *types.CoreEvalProposal) err
 	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, 0))
 	return k.PushHighPriorityAction(ctx, action)
 }
+
+// HighPrioritySendersProposal adds and removes high priority senders on behalf
+// of the proposal's namespace.
+func (k Keeper) HighPrioritySendersProposal(ctx sdk.Context, p *types.HighPrioritySendersProposal) error {
+	for _, bech32 := range p.Add {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			return err
+		}
+		if err := k.AddHighPrioritySender(ctx, p.Namespace, addr); err != nil {
+			return err
+		}
+	}
+	for _, bech32 := range p.Remove {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			return err
+		}
+		if err := k.RemoveHighPrioritySender(ctx, p.Namespace, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// SnapshotOperationCreate and SnapshotOperationRestore are the values of
+// SnapshotProgress.Operation
+const (
+	SnapshotOperationCreate  = "create"
+	SnapshotOperationRestore = "restore"
+)
+
+// SnapshotProgress reports the progress of the swingset extension of the
+// state-sync snapshot being created or restored, or of the last one if none is
+// in progress.
+type SnapshotProgress struct {
+	// Operation is either SnapshotOperationCreate or SnapshotOperationRestore,
+	// or empty if no snapshot was created or restored since the node started.
+	Operation string `json:"operation"`
+	// BlockHeight is the block height of the snapshot.
+	BlockHeight uint64 `json:"blockHeight"`
+	// Active indicates whether the operation is still in progress.
+	Active bool `json:"active"`
+	// StartedAt is the time at which the operation started.
+	StartedAt time.Time `json:"startedAt"`
+	// ElapsedSeconds is the duration of the operation so far.
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+	// CurrentArtifact is the name of the artifact being processed, if any.
+	CurrentArtifact string `json:"currentArtifact,omitempty"`
+	// Artifacts is the number of artifacts processed.
+	Artifacts uint64 `json:"artifacts"`
+	// ArtifactsByKind is the number of artifacts processed by kind, the kind
+	// being the prefix of the artifact name (e.g. "transcript", "snapshot").
+	ArtifactsByKind map[string]uint64 `json:"artifactsByKind"`
+	// ArtifactBytes is the total size of the artifacts processed.
+	ArtifactBytes uint64 `json:"artifactBytes"`
+	// PayloadBytes is the total size of the extension payloads written or read.
+	PayloadBytes uint64 `json:"payloadBytes"`
+	// Error is the error that ended the operation, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// snapshotProgressTracker records the progress of snapshot operations.
+// It is updated from the snapshot goroutines, and may be read concurrently
+// from any goroutine.
+type snapshotProgressTracker struct {
+	mu       sync.Mutex
+	progress SnapshotProgress
+}
+
+func newSnapshotProgressTracker() *snapshotProgressTracker {
+	return &snapshotProgressTracker{}
+}
+
+// artifactKind returns the kind of an artifact from its name.
+func artifactKind(name string) string {
+	kind, _, _ := strings.Cut(name, ".")
+	return kind
+}
+
+func (tracker *snapshotProgressTracker) start(operation string, blockHeight uint64) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress = SnapshotProgress{
+		Operation:       operation,
+		BlockHeight:     blockHeight,
+		Active:          true,
+		StartedAt:       time.Now(),
+		ArtifactsByKind: map[string]uint64{},
+	}
+	tracker.emitTelemetry()
+}
+
+func (tracker *snapshotProgressTracker) beginArtifact(name string) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress.CurrentArtifact = name
+}
+
+func (tracker *snapshotProgressTracker) endArtifact(name string, size int) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress.CurrentArtifact = ""
+	tracker.progress.Artifacts++
+	tracker.progress.ArtifactsByKind[artifactKind(name)]++
+	tracker.progress.ArtifactBytes += uint64(size)
+	tracker.emitTelemetry()
+}
+
+func (tracker *snapshotProgressTracker) addPayloadBytes(size int) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress.PayloadBytes += uint64(size)
+}
+
+// finish ends the operation in progress, and logs a summary of it.
+func (tracker *snapshotProgressTracker) finish(logger log.Logger, err error) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	progress := &tracker.progress
+	progress.Active = false
+	progress.CurrentArtifact = ""
+	progress.ElapsedSeconds = time.Since(progress.StartedAt).Seconds()
+	if err != nil {
+		progress.Error = err.Error()
+	}
+	tracker.emitTelemetry()
+
+	kinds := make([]string, 0, len(progress.ArtifactsByKind))
+	for kind := range progress.ArtifactsByKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	keyvals := []interface{}{
+		"operation", progress.Operation,
+		"height", progress.BlockHeight,
+		"artifacts", progress.Artifacts,
+		"artifactBytes", progress.ArtifactBytes,
+		"payloadBytes", progress.PayloadBytes,
+		"elapsed", time.Duration(progress.ElapsedSeconds * float64(time.Second)).Round(time.Millisecond).String(),
+	}
+	for _, kind := range kinds {
+		keyvals = append(keyvals, kind, progress.ArtifactsByKind[kind])
+	}
+	if err != nil {
+		logger.Error("swingset snapshot extension failed", append(keyvals, "err", err)...)
+	} else {
+		logger.Info("swingset snapshot extension summary", keyvals...)
+	}
+}
+
+// emitTelemetry updates the telemetry gauges. Must be called with mu held.
+func (tracker *snapshotProgressTracker) emitTelemetry() {
+	progress := &tracker.progress
+	labels := []metrics.Label{telemetry.NewLabel("operation", progress.Operation)}
+	active := float32(0)
+	if progress.Active {
+		active = 1
+	}
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "active"}, active, labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "height"}, float32(progress.BlockHeight), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "artifacts"}, float32(progress.Artifacts), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "artifact_bytes"}, float32(progress.ArtifactBytes), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "payload_bytes"}, float32(progress.PayloadBytes), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "snapshot", "elapsed_seconds"}, float32(time.Since(progress.StartedAt).Seconds()), labels)
+}
+
+// get returns a copy of the current progress.
+func (tracker *snapshotProgressTracker) get() SnapshotProgress {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	progress := tracker.progress
+	if progress.Active {
+		progress.ElapsedSeconds = time.Since(progress.StartedAt).Seconds()
+	}
+	progress.ArtifactsByKind = make(map[string]uint64, len(tracker.progress.ArtifactsByKind))
+	for kind, count := range tracker.progress.ArtifactsByKind {
+		progress.ArtifactsByKind[kind] = count
+	}
+	return progress
+}
+
+// SnapshotProgress returns the progress of the swingset extension snapshot
+// being created or restored, or of the last one.
+// Safe to call from any goroutine.
+func (snapshotter *ExtensionSnapshotter) SnapshotProgress() SnapshotProgress {
+	return snapshotter.progress.get()
+}
+
+// SnapshotProgressHandler is an http handler reporting the SnapshotProgress
+// as JSON, for use as a local status endpoint.
+func (snapshotter *ExtensionSnapshotter) SnapshotProgressHandler(w http.ResponseWriter, r *http.Request) {
+	bz, err := json.Marshal(snapshotter.SnapshotProgress())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
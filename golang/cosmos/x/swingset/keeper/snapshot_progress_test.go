@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestSnapshotProgress(t *testing.T) {
+	snapshotter := newTestExtensionSnapshotter()
+	snapshotter.progress.start(SnapshotOperationCreate, 123)
+	snapshotter.progress.beginArtifact("transcript.v1.0.1")
+	snapshotter.progress.addPayloadBytes(5)
+
+	progress := snapshotter.SnapshotProgress()
+	if !progress.Active || progress.CurrentArtifact != "transcript.v1.0.1" || progress.BlockHeight != 123 {
+		t.Errorf("unexpected progress %+v", progress)
+	}
+
+	snapshotter.progress.endArtifact("transcript.v1.0.1", 10)
+	snapshotter.progress.endArtifact("transcript.v2.0.1", 20)
+	snapshotter.progress.endArtifact("snapshot.v1.2", 30)
+	snapshotter.progress.endArtifact(UntrustedExportDataArtifactName, 40)
+	snapshotter.progress.finish(log.NewNopLogger(), errors.New("boom"))
+
+	recorder := httptest.NewRecorder()
+	snapshotter.SnapshotProgressHandler(recorder, httptest.NewRequest("GET", "/agoric/swingset/snapshot_progress", nil))
+	var reported SnapshotProgress
+	if err := json.Unmarshal(recorder.Body.Bytes(), &reported); err != nil {
+		t.Fatal(err)
+	}
+
+	if reported.Active || reported.CurrentArtifact != "" || reported.Error != "boom" {
+		t.Errorf("unexpected progress %+v", reported)
+	}
+	if reported.Artifacts != 4 || reported.ArtifactBytes != 100 || reported.PayloadBytes != 5 {
+		t.Errorf("unexpected progress counts %+v", reported)
+	}
+	expectedKinds := map[string]uint64{"transcript": 2, "snapshot": 1, UntrustedExportDataArtifactName: 1}
+	if !reflect.DeepEqual(reported.ArtifactsByKind, expectedKinds) {
+		t.Errorf("got artifacts by kind %v, wanted %v", reported.ArtifactsByKind, expectedKinds)
+	}
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// A swing-store export archive is a zstd compressed tar stream holding the
+// same files as an export directory, in an order allowing the export to be
+// consumed while streaming the archive:
+// - the export manifest
+// - the "export data" file, if any
+// - the artifact files, in the order listed by the manifest
+// - the untrusted "export data" file, if any
+// Extracting an archive results in an export directory that can be opened
+// with OpenSwingStoreExportDirectory.
+
+// SwingStoreExportArchiveExtension is the conventional file extension of
+// swing-store export archives.
+const SwingStoreExportArchiveExtension = ".tar.zst"
+
+// countingWriter is an io.Writer keeping track of the bytes written to it.
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.writer.Write(p)
+	cw.count += int64(n)
+	return n, err
+}
+
+// WriteSwingStoreExportToArchive consumes a provider and writes the swing-store
+// export as an archive into the provided writer, naming the artifact files
+// according to the provided layout (one of the SwingStoreExportLayout* const
+// values).
+//
+// Since the manifest comes first in the archive but is only known once all the
+// artifacts have been read from the provider, the content of the export is
+// first spooled to a temporary file.
+func WriteSwingStoreExportToArchive(provider SwingStoreExportProvider, archive io.Writer, layout string) error {
+	if !isValidSwingStoreExportLayout(layout) {
+		return fmt.Errorf("invalid export layout %q", layout)
+	}
+
+	spool, err := os.CreateTemp("", fmt.Sprintf("agd-swing-store-archive-%d-*", provider.BlockHeight))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	type spooledFile struct {
+		name string
+		size int64
+	}
+	var spooledFiles []spooledFile
+	var untrustedExportData []byte
+
+	manifest := exportManifest{
+		BlockHeight: provider.BlockHeight,
+	}
+
+	exportDataReader, err := provider.GetExportDataReader()
+	if err != nil {
+		return err
+	}
+
+	if exportDataReader != nil {
+		defer exportDataReader.Close()
+
+		manifest.Data = exportDataFilename
+		counter := &countingWriter{writer: spool}
+		err = agoric.EncodeKVEntryReaderToJsonl(exportDataReader, counter)
+		if err != nil {
+			return err
+		}
+		spooledFiles = append(spooledFiles, spooledFile{name: exportDataFilename, size: counter.count})
+	}
+
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if artifact.Name == UntrustedExportDataArtifactName {
+			untrustedExportData = artifact.Data
+			continue
+		}
+
+		filename := swingStoreArtifactFilename(layout, len(manifest.Artifacts), artifact)
+		manifest.Artifacts = append(manifest.Artifacts, [2]string{artifact.Name, filename})
+		_, err = spool.Write(artifact.Data)
+		if err != nil {
+			return err
+		}
+		spooledFiles = append(spooledFiles, spooledFile{name: filename, size: int64(len(artifact.Data))})
+	}
+
+	_, err = spool.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	zstdWriter, err := zstd.NewWriter(archive)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(zstdWriter)
+
+	writeHeader := func(name string, size int64) error {
+		return tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     size,
+			Mode:     exportedFilesMode,
+			Format:   tar.FormatPAX,
+		})
+	}
+	writeFile := func(name string, data []byte) error {
+		err := writeHeader(name, int64(len(data)))
+		if err != nil {
+			return err
+		}
+		_, err = tarWriter.Write(data)
+		return err
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = writeFile(ExportManifestFilename, manifestBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range spooledFiles {
+		err = writeHeader(file.name, file.size)
+		if err != nil {
+			return err
+		}
+		_, err = io.CopyN(tarWriter, spool, file.size)
+		if err != nil {
+			return err
+		}
+	}
+
+	if untrustedExportData != nil {
+		err = writeFile(untrustedExportDataFilename, untrustedExportData)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+	return zstdWriter.Close()
+}
+
+// OpenSwingStoreExportArchive creates an export provider streaming the
+// swing-store export from an archive created by WriteSwingStoreExportToArchive,
+// without extracting it to disk.
+//
+// Since the archive is read sequentially, the provider's GetExportDataReader
+// must be called, and the returned reader consumed, before the first call to
+// ReadNextArtifact. Once all artifacts have been read, the archive is no
+// longer accessed.
+func OpenSwingStoreExportArchive(archive io.Reader) (SwingStoreExportProvider, error) {
+	zstdReader, err := zstd.NewReader(archive, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return SwingStoreExportProvider{}, err
+	}
+	tarReader := tar.NewReader(zstdReader)
+
+	// nextFile advances to the next file of the archive, which must be named
+	// expectedName.
+	nextFile := func(expectedName string) error {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return fmt.Errorf("archive ended before %s", expectedName)
+		} else if err != nil {
+			return err
+		}
+		if header.Name != expectedName {
+			return fmt.Errorf("unexpected file %s in archive, expected %s", header.Name, expectedName)
+		}
+		return nil
+	}
+
+	err = nextFile(ExportManifestFilename)
+	if err != nil {
+		zstdReader.Close()
+		return SwingStoreExportProvider{}, err
+	}
+
+	var manifest exportManifest
+	err = json.NewDecoder(tarReader).Decode(&manifest)
+	if err != nil {
+		zstdReader.Close()
+		return SwingStoreExportProvider{}, err
+	}
+
+	exportDataConsumed := manifest.Data == ""
+
+	getExportDataReader := func() (agoric.KVEntryReader, error) {
+		if manifest.Data == "" {
+			return nil, nil
+		}
+		if exportDataConsumed {
+			return nil, errors.New("export data already read or skipped from archive")
+		}
+		exportDataConsumed = true
+
+		err := nextFile(manifest.Data)
+		if err != nil {
+			return nil, err
+		}
+		return agoric.NewJsonlKVEntryDecoderReader(io.NopCloser(tarReader)), nil
+	}
+
+	nextArtifact := 0
+
+	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+		if nextArtifact == len(manifest.Artifacts) {
+			zstdReader.Close()
+			return artifact, io.EOF
+		} else if nextArtifact > len(manifest.Artifacts) {
+			return artifact, fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
+		}
+
+		if !exportDataConsumed {
+			// skip the unread export data
+			exportDataConsumed = true
+			err = nextFile(manifest.Data)
+			if err != nil {
+				return artifact, err
+			}
+		}
+
+		artifactEntry := manifest.Artifacts[nextArtifact]
+		nextArtifact++
+
+		artifactName := artifactEntry[0]
+		fileName := artifactEntry[1]
+		if artifactName == UntrustedExportDataArtifactName {
+			return artifact, fmt.Errorf("unexpected export artifact name %s", artifactName)
+		}
+
+		err = nextFile(fileName)
+		if err != nil {
+			return artifact, err
+		}
+		artifact.Name = artifactName
+		artifact.Data, err = io.ReadAll(tarReader)
+
+		return artifact, err
+	}
+
+	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
+}
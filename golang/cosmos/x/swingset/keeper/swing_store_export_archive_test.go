@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func newTestExportProvider(exportData []agoric.KVEntry, artifacts []types.SwingStoreArtifact) SwingStoreExportProvider {
+	provider := newSliceExportProvider(artifacts)
+	provider.GetExportDataReader = func() (agoric.KVEntryReader, error) {
+		if exportData == nil {
+			return nil, nil
+		}
+		entries := make([]*types.SwingStoreExportDataEntry, len(exportData))
+		for i, entry := range exportData {
+			entries[i] = &types.SwingStoreExportDataEntry{Key: entry.Key(), Value: entry.StringValue()}
+		}
+		return agoric.NewSwingStoreExportDataEntriesReader(entries), nil
+	}
+	return provider
+}
+
+func readAllExportData(t *testing.T, provider SwingStoreExportProvider) []agoric.KVEntry {
+	reader, err := provider.GetExportDataReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reader == nil {
+		return nil
+	}
+	defer reader.Close()
+	entries := []agoric.KVEntry{}
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return entries
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func readAllArtifacts(t *testing.T, provider SwingStoreExportProvider) []types.SwingStoreArtifact {
+	artifacts := []types.SwingStoreArtifact{}
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			return artifacts
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+}
+
+var testExportData = []agoric.KVEntry{
+	agoric.NewKVEntry("kv.foo", "bar"),
+	agoric.NewKVEntry("bundle.b1-123", "b1-123"),
+}
+
+var testArtifacts = []types.SwingStoreArtifact{
+	{Name: "transcript.v1.0.1", Data: []byte("item\n")},
+	{Name: "bundle.b1-123", Data: []byte("bundle")},
+	{Name: "transcript.v2.0.1", Data: []byte("item\n")},
+}
+
+func TestSwingStoreExportArchive(t *testing.T) {
+	for _, layout := range []string{SwingStoreExportLayoutFlat, SwingStoreExportLayoutContentAddressed} {
+		t.Run(layout, func(t *testing.T) {
+			untrusted := types.SwingStoreArtifact{Name: UntrustedExportDataArtifactName, Data: []byte("untrusted")}
+			provider := newTestExportProvider(testExportData, append(append([]types.SwingStoreArtifact{}, testArtifacts...), untrusted))
+
+			var archive bytes.Buffer
+			err := WriteSwingStoreExportToArchive(provider, &archive, layout)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			restored, err := OpenSwingStoreExportArchive(bytes.NewReader(archive.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if restored.BlockHeight != provider.BlockHeight {
+				t.Errorf("got block height %d, want %d", restored.BlockHeight, provider.BlockHeight)
+			}
+			if got := readAllExportData(t, restored); !reflect.DeepEqual(got, testExportData) {
+				t.Errorf("got export data %v, want %v", got, testExportData)
+			}
+			if got := readAllArtifacts(t, restored); !reflect.DeepEqual(got, testArtifacts) {
+				t.Errorf("got artifacts %v, want %v", got, testArtifacts)
+			}
+
+			// Reading artifacts first skips the export data.
+			restored, err = OpenSwingStoreExportArchive(bytes.NewReader(archive.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readAllArtifacts(t, restored); !reflect.DeepEqual(got, testArtifacts) {
+				t.Errorf("got artifacts %v, want %v", got, testArtifacts)
+			}
+			if _, err := restored.GetExportDataReader(); err == nil {
+				t.Error("expected an error reading export data after artifacts")
+			}
+		})
+	}
+
+	if _, err := OpenSwingStoreExportArchive(bytes.NewReader([]byte("not an archive"))); err == nil {
+		t.Error("expected an error opening an invalid archive")
+	}
+}
+
+func TestSwingStoreExportContentAddressedDirectory(t *testing.T) {
+	exportDir := t.TempDir()
+
+	first := newTestExportProvider(testExportData, testArtifacts)
+	first.BlockHeight = 10
+	err := WriteSwingStoreExportToDirectoryWithLayout(first, exportDir, SwingStoreExportLayoutContentAddressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err := os.ReadDir(filepath.Join(exportDir, contentAddressedObjectsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 2 {
+		t.Errorf("got %d objects, want 2 distinct artifact contents", len(objects))
+	}
+
+	// A new export over the previous one only adds the changed artifacts.
+	changed := append([]types.SwingStoreArtifact{}, testArtifacts[:2]...)
+	changed = append(changed, types.SwingStoreArtifact{Name: "transcript.v2.0.2", Data: []byte("item\nitem2\n")})
+	second := newTestExportProvider(nil, changed)
+	second.BlockHeight = 20
+	err = WriteSwingStoreExportToDirectoryWithLayout(second, exportDir, SwingStoreExportLayoutContentAddressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err = os.ReadDir(filepath.Join(exportDir, contentAddressedObjectsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 3 {
+		t.Errorf("got %d objects, want 3", len(objects))
+	}
+
+	// The latest export is selected by default, or by its height.
+	for _, blockHeight := range []uint64{0, 20} {
+		provider, err := OpenSwingStoreExportDirectory(exportDir, blockHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if provider.BlockHeight != 20 {
+			t.Errorf("got export height %d, want 20", provider.BlockHeight)
+		}
+		if got := readAllExportData(t, provider); got != nil {
+			t.Errorf("got export data %v, want none", got)
+		}
+		if got := readAllArtifacts(t, provider); !reflect.DeepEqual(got, changed) {
+			t.Errorf("got artifacts %v, want %v", got, changed)
+		}
+	}
+
+	// The previous export is still available.
+	provider, err := OpenSwingStoreExportDirectory(exportDir, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readAllExportData(t, provider); !reflect.DeepEqual(got, testExportData) {
+		t.Errorf("got export data %v, want %v", got, testExportData)
+	}
+	if got := readAllArtifacts(t, provider); !reflect.DeepEqual(got, testArtifacts) {
+		t.Errorf("got artifacts %v, want %v", got, testArtifacts)
+	}
+
+	if _, err := OpenSwingStoreExportDirectory(exportDir, 15); err == nil {
+		t.Error("expected an error opening a missing export")
+	}
+}
@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The swing-store "export data" is a shadow copy of the JS swing-store,
+// written under swingStoreKeyPrefix by handleSwingStoreUpdateExportData.
+// Its keys start with the kind of entry they describe: "kv.", "transcript.",
+// "snapshot." or "bundle.".
+
+// swingStoreExportDataKeyPatterns is the grammar of the "export data" keys
+// produced by the JS swing-store, by kind of entry.
+var swingStoreExportDataKeyPatterns = map[string]*regexp.Regexp{
+	// kv.${key}
+	"kv": regexp.MustCompile(`^kv\..+$`),
+	// transcript.${vatID}.current or transcript.${vatID}.${startPos}
+	"transcript": regexp.MustCompile(`^transcript\.v[0-9]+\.(current|[0-9]+)$`),
+	// snapshot.${vatID}.current or snapshot.${vatID}.${snapPos}
+	"snapshot": regexp.MustCompile(`^snapshot\.v[0-9]+\.(current|[0-9]+)$`),
+	// bundle.${bundleID}
+	"bundle": regexp.MustCompile(`^bundle\.b[0-9]+-[0-9a-f]+$`),
+}
+
+// swingStoreExportDataKind returns the kind of an "export data" key, which is
+// its first segment.
+func swingStoreExportDataKind(key string) string {
+	kind, _, _ := strings.Cut(key, ".")
+	return kind
+}
+
+// ValidateSwingStoreExportDataKey checks that key follows the grammar of the
+// "export data" keys.
+func ValidateSwingStoreExportDataKey(key string) error {
+	pattern, ok := swingStoreExportDataKeyPatterns[swingStoreExportDataKind(key)]
+	if !ok || !pattern.MatchString(key) {
+		return fmt.Errorf("invalid swing-store export data key %q", key)
+	}
+	return nil
+}
+
+// getSwingStoreExportDataBlockWritten returns the bytes of "export data"
+// written so far in the current block.
+func (k Keeper) getSwingStoreExportDataBlockWritten(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(swingStoreExportDataBlockUsageKey))
+	if bz == nil {
+		return 0
+	}
+	var usage types.SwingStoreExportDataBlockUsage
+	if err := usage.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	if usage.BlockHeight != ctx.BlockHeight() {
+		return 0
+	}
+	return usage.WrittenBytes
+}
+
+func (k Keeper) setSwingStoreExportDataBlockWritten(ctx sdk.Context, written uint64) {
+	usage := types.SwingStoreExportDataBlockUsage{BlockHeight: ctx.BlockHeight(), WrittenBytes: written}
+	bz, err := usage.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(swingStoreExportDataBlockUsageKey), bz)
+}
+
+// UpdateSwingStoreExportData applies a batch of "export data" updates, where
+// an entry without value is a deletion, while keeping the stats of the
+// "export data" up to date.
+// The JS swing-store has already committed the updates, so they are applied
+// even if some keys are invalid or the bytes written in the block exceed the
+// ExportDataBlockBudget param. Such anomalies are reported instead, by an
+// EventSwingStoreExportDataAlarm, telemetry and the log.
+func (k Keeper) UpdateSwingStoreExportData(ctx sdk.Context, entries []agoric.KVEntry) error {
+	written := uint64(0)
+	invalidKeys := []string{}
+	for _, entry := range entries {
+		if err := ValidateSwingStoreExportDataKey(entry.Key()); err != nil {
+			invalidKeys = append(invalidKeys, entry.Key())
+		}
+		written += uint64(len(entry.Key()) + len(entry.StringValue()))
+	}
+
+	blockWritten := k.getSwingStoreExportDataBlockWritten(ctx) + written
+	k.setSwingStoreExportDataBlockWritten(ctx, blockWritten)
+	telemetry.SetGauge(float32(blockWritten), types.ModuleName, "export_data", "block_written_bytes")
+
+	budget := k.GetParams(ctx).ExportDataBlockBudget
+	overBudget := budget != 0 && blockWritten > budget
+	if overBudget || len(invalidKeys) > 0 {
+		if overBudget {
+			telemetry.IncrCounter(1, types.ModuleName, "export_data", "over_budget_updates")
+		}
+		telemetry.IncrCounter(float32(len(invalidKeys)), types.ModuleName, "export_data", "invalid_keys")
+		k.Logger(ctx).Error("swing-store export data update anomaly",
+			"blockWrittenBytes", blockWritten, "blockBudget", budget, "invalidKeys", invalidKeys)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventSwingStoreExportDataAlarm{
+			BlockWrittenBytes: blockWritten,
+			BlockBudget:       budget,
+			InvalidKeys:       invalidKeys,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	store := k.GetSwingStore(ctx)
+	sizes := swingStoreExportDataSizesByKind(k.GetSwingStoreExportDataStats(ctx))
+	for _, entry := range entries {
+		key := []byte(entry.Key())
+		kind := swingStoreExportDataKind(entry.Key())
+		size, ok := sizes[kind]
+		if !ok {
+			// An invalid key may have an unknown kind.
+			size = &types.SwingStoreExportDataSize{Kind: kind}
+			sizes[kind] = size
+		}
+		if previous := store.Get(key); previous != nil {
+			size.Entries--
+			size.Bytes -= uint64(len(key) + len(previous))
+		}
+		if !entry.HasValue() {
+			store.Delete(key)
+		} else {
+			value := []byte(entry.StringValue())
+			store.Set(key, value)
+			size.Entries++
+			size.Bytes += uint64(len(key) + len(value))
+		}
+	}
+	stats := swingStoreExportDataStatsFromSizes(sizes)
+	k.setSwingStoreExportDataStats(ctx, stats)
+
+	emitSwingStoreExportDataTelemetry(stats, written)
+	return nil
+}
+
+// GetSwingStoreExportDataStats returns the number of entries and size of the
+// "export data" by kind of entry.
+func (k Keeper) GetSwingStoreExportDataStats(ctx sdk.Context) types.SwingStoreExportDataStats {
+	stats := types.SwingStoreExportDataStats{Sizes: []types.SwingStoreExportDataSize{}}
+	bz := ctx.KVStore(k.storeKey).Get([]byte(swingStoreExportDataStatsKey))
+	if bz != nil {
+		if err := stats.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return stats
+}
+
+func (k Keeper) setSwingStoreExportDataStats(ctx sdk.Context, stats types.SwingStoreExportDataStats) {
+	bz, err := stats.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(swingStoreExportDataStatsKey), bz)
+}
+
+// RecomputeSwingStoreExportDataStats computes the stats of the "export data"
+// from scratch, for when it was written without accounting, e.g. by
+// InitGenesis or before the stats existed.
+func (k Keeper) RecomputeSwingStoreExportDataStats(ctx sdk.Context) {
+	sizes := map[string]*types.SwingStoreExportDataSize{}
+	iterator := k.GetSwingStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		kind := swingStoreExportDataKind(string(iterator.Key()))
+		size, ok := sizes[kind]
+		if !ok {
+			size = &types.SwingStoreExportDataSize{Kind: kind}
+			sizes[kind] = size
+		}
+		size.Entries++
+		size.Bytes += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+	k.setSwingStoreExportDataStats(ctx, swingStoreExportDataStatsFromSizes(sizes))
+}
+
+// swingStoreExportDataSizesByKind indexes the stats by kind, including all
+// known kinds of entries.
+func swingStoreExportDataSizesByKind(stats types.SwingStoreExportDataStats) map[string]*types.SwingStoreExportDataSize {
+	sizes := make(map[string]*types.SwingStoreExportDataSize, len(swingStoreExportDataKeyPatterns))
+	for kind := range swingStoreExportDataKeyPatterns {
+		sizes[kind] = &types.SwingStoreExportDataSize{Kind: kind}
+	}
+	for i := range stats.Sizes {
+		size := stats.Sizes[i]
+		sizes[size.Kind] = &size
+	}
+	return sizes
+}
+
+func swingStoreExportDataStatsFromSizes(sizes map[string]*types.SwingStoreExportDataSize) types.SwingStoreExportDataStats {
+	stats := types.SwingStoreExportDataStats{Sizes: make([]types.SwingStoreExportDataSize, 0, len(sizes))}
+	for _, size := range sizes {
+		stats.Sizes = append(stats.Sizes, *size)
+	}
+	sort.Slice(stats.Sizes, func(i, j int) bool {
+		return stats.Sizes[i].Kind < stats.Sizes[j].Kind
+	})
+	return stats
+}
+
+// emitSwingStoreExportDataTelemetry updates the gauges of the "export data"
+// size, and counts the bytes written by an update.
+func emitSwingStoreExportDataTelemetry(stats types.SwingStoreExportDataStats, written uint64) {
+	for _, size := range stats.Sizes {
+		labels := []metrics.Label{telemetry.NewLabel("kind", size.Kind)}
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "export_data", "entries"}, float32(size.Entries), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "export_data", "bytes"}, float32(size.Bytes), labels)
+	}
+	telemetry.IncrCounter(float32(written), types.ModuleName, "export_data", "written_bytes")
+}
+
+// PaginateSwingStoreExportData returns the swing-store "export data" entries
+// whose key starts with keyPrefix and selected by pageReq, in lexicographic
+// order of their key. If keysOnly is true, the values are omitted.
+func (k Keeper) PaginateSwingStoreExportData(ctx sdk.Context, keyPrefix string, keysOnly bool, pageReq *query.PageRequest) ([]types.SwingStoreExportDataEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(k.GetSwingStore(ctx), []byte(keyPrefix))
+	entries := []types.SwingStoreExportDataEntry{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		entry := types.SwingStoreExportDataEntry{Key: keyPrefix + string(key)}
+		if !keysOnly {
+			entry.Value = string(value)
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// GetSwingStoreExportDataEntry returns the value of a swing-store "export
+// data" entry, and whether it exists.
+func (k Keeper) GetSwingStoreExportDataEntry(ctx sdk.Context, key string) (string, bool) {
+	value := k.GetSwingStore(ctx).Get([]byte(key))
+	if value == nil {
+		return "", false
+	}
+	return string(value), true
+}
+
+// SwingStoreExportDataChange is an "export data" entry with different values
+// on both sides of a diff.
+type SwingStoreExportDataChange struct {
+	Key    string `json:"key"`
+	ValueA string `json:"valueA"`
+	ValueB string `json:"valueB"`
+}
+
+// SwingStoreExportDataDiff describes the differences between two versions A
+// and B of the swing-store "export data".
+type SwingStoreExportDataDiff struct {
+	// OnlyInA are the entries absent from B.
+	OnlyInA []agoric.KVEntry `json:"onlyInA"`
+	// OnlyInB are the entries absent from A.
+	OnlyInB []agoric.KVEntry `json:"onlyInB"`
+	// Changed are the entries with different values in A and B.
+	Changed []SwingStoreExportDataChange `json:"changed"`
+	// Groups counts the differing entries by group, the group being the kind
+	// of the entry followed by the vat ID if any (e.g. "kv.v12",
+	// "transcript.v3", "bundle").
+	Groups map[string]uint64 `json:"groups"`
+}
+
+// SwingStoreExportDataGroup returns the group of an "export data" key, used
+// to summarize differences by kind of entry and vat.
+func SwingStoreExportDataGroup(key string) string {
+	segments := strings.SplitN(key, ".", 3)
+	if len(segments) < 3 || segments[0] == "bundle" {
+		return segments[0]
+	}
+	return segments[0] + "." + segments[1]
+}
+
+// DiffSwingStoreExportData compares two versions of the "export data", each
+// read in lexicographic order of the keys. Both readers are closed.
+func DiffSwingStoreExportData(readerA agoric.KVEntryReader, readerB agoric.KVEntryReader) (*SwingStoreExportDataDiff, error) {
+	defer readerA.Close()
+	defer readerB.Close()
+
+	diff := &SwingStoreExportDataDiff{
+		OnlyInA: []agoric.KVEntry{},
+		OnlyInB: []agoric.KVEntry{},
+		Changed: []SwingStoreExportDataChange{},
+		Groups:  map[string]uint64{},
+	}
+
+	readNext := func(reader agoric.KVEntryReader) (*agoric.KVEntry, error) {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return &entry, nil
+	}
+
+	entryA, err := readNext(readerA)
+	if err != nil {
+		return nil, err
+	}
+	entryB, err := readNext(readerB)
+	if err != nil {
+		return nil, err
+	}
+
+	for entryA != nil || entryB != nil {
+		switch {
+		case entryB == nil || (entryA != nil && entryA.Key() < entryB.Key()):
+			diff.OnlyInA = append(diff.OnlyInA, *entryA)
+			diff.Groups[SwingStoreExportDataGroup(entryA.Key())]++
+			entryA, err = readNext(readerA)
+
+		case entryA == nil || entryB.Key() < entryA.Key():
+			diff.OnlyInB = append(diff.OnlyInB, *entryB)
+			diff.Groups[SwingStoreExportDataGroup(entryB.Key())]++
+			entryB, err = readNext(readerB)
+
+		default:
+			if entryA.StringValue() != entryB.StringValue() {
+				diff.Changed = append(diff.Changed, SwingStoreExportDataChange{
+					Key:    entryA.Key(),
+					ValueA: entryA.StringValue(),
+					ValueB: entryB.StringValue(),
+				})
+				diff.Groups[SwingStoreExportDataGroup(entryA.Key())]++
+			}
+			entryA, err = readNext(readerA)
+			if err == nil {
+				entryB, err = readNext(readerB)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
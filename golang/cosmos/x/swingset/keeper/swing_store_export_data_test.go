@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"reflect"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestPaginateSwingStoreExportData(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	keeper := Keeper{storeKey: storeKey}
+
+	swingStore := keeper.GetSwingStore(ctx)
+	swingStore.Set([]byte("bundle.b1-123"), []byte("b1-123"))
+	swingStore.Set([]byte("kv.v1.foo"), []byte("bar"))
+	swingStore.Set([]byte("kv.v2.foo"), []byte("baz"))
+
+	entries, _, err := keeper.PaginateSwingStoreExportData(ctx, "kv.", false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEntries := []types.SwingStoreExportDataEntry{
+		{Key: "kv.v1.foo", Value: "bar"},
+		{Key: "kv.v2.foo", Value: "baz"},
+	}
+	if !reflect.DeepEqual(entries, wantEntries) {
+		t.Errorf("got entries %v, want %v", entries, wantEntries)
+	}
+
+	entries, pageRes, err := keeper.PaginateSwingStoreExportData(ctx, "", true, &query.PageRequest{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEntries = []types.SwingStoreExportDataEntry{{Key: "bundle.b1-123"}, {Key: "kv.v1.foo"}}
+	if !reflect.DeepEqual(entries, wantEntries) {
+		t.Errorf("got entries %v, want %v", entries, wantEntries)
+	}
+	if len(pageRes.NextKey) == 0 {
+		t.Error("expected a next page")
+	}
+
+	if value, found := keeper.GetSwingStoreExportDataEntry(ctx, "kv.v2.foo"); !found || value != "baz" {
+		t.Errorf("got entry %q %v, want baz", value, found)
+	}
+	if _, found := keeper.GetSwingStoreExportDataEntry(ctx, "kv.v3.foo"); found {
+		t.Error("unexpected entry kv.v3.foo")
+	}
+}
+
+func TestDiffSwingStoreExportData(t *testing.T) {
+	entriesA := []agoric.KVEntry{
+		agoric.NewKVEntry("bundle.b1-123", "b1-123"),
+		agoric.NewKVEntry("kv.v1.foo", "bar"),
+		agoric.NewKVEntry("kv.v2.foo", "baz"),
+		agoric.NewKVEntry("transcript.v1.current", "{\"startPos\":0,\"endPos\":2}"),
+	}
+	entriesB := []agoric.KVEntry{
+		agoric.NewKVEntry("kv.v1.foo", "bar"),
+		agoric.NewKVEntry("kv.v2.foo", "qux"),
+		agoric.NewKVEntry("kv.v2.new", "1"),
+		agoric.NewKVEntry("transcript.v1.current", "{\"startPos\":0,\"endPos\":3}"),
+	}
+
+	diff, err := DiffSwingStoreExportData(newTestExportDataReader(entriesA), newTestExportDataReader(entriesB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &SwingStoreExportDataDiff{
+		OnlyInA: []agoric.KVEntry{entriesA[0]},
+		OnlyInB: []agoric.KVEntry{entriesB[2]},
+		Changed: []SwingStoreExportDataChange{
+			{Key: "kv.v2.foo", ValueA: "baz", ValueB: "qux"},
+			{Key: "transcript.v1.current", ValueA: entriesA[3].StringValue(), ValueB: entriesB[3].StringValue()},
+		},
+		Groups: map[string]uint64{"bundle": 1, "kv.v2": 2, "transcript.v1": 1},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("got diff %+v, want %+v", diff, want)
+	}
+}
+
+func newTestExportDataReader(entries []agoric.KVEntry) agoric.KVEntryReader {
+	exportDataEntries := make([]*types.SwingStoreExportDataEntry, len(entries))
+	for i, entry := range entries {
+		exportDataEntries[i] = &types.SwingStoreExportDataEntry{Key: entry.Key(), Value: entry.StringValue()}
+	}
+	return agoric.NewSwingStoreExportDataEntriesReader(exportDataEntries)
+}
+
+func TestValidateSwingStoreExportDataKey(t *testing.T) {
+	for _, key := range []string{
+		"kv.activityhash",
+		"kv.v12.vs.vom.o+d10/1",
+		"transcript.v1.current",
+		"transcript.v1.42",
+		"snapshot.v2.current",
+		"snapshot.v2.1234",
+		"bundle.b1-0123456789abcdef",
+	} {
+		if err := ValidateSwingStoreExportDataKey(key); err != nil {
+			t.Errorf("unexpected error for %s: %v", key, err)
+		}
+	}
+	for _, key := range []string{
+		"",
+		"kv.",
+		"foo.bar",
+		"transcript.v1",
+		"transcript.v1.0.1",
+		"transcript.kernel.current",
+		"snapshot.v1.latest",
+		"bundle.foo",
+	} {
+		if err := ValidateSwingStoreExportDataKey(key); err == nil {
+			t.Errorf("expected error for %q", key)
+		}
+	}
+}
+
+func TestUpdateSwingStoreExportData(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(nil), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	keeper := Keeper{storeKey: storeKey, paramSpace: paramSpace}
+	params := types.DefaultParams()
+	params.ExportDataBlockBudget = 40
+	keeper.SetParams(ctx, params)
+
+	sizeOf := func(kind string) types.SwingStoreExportDataSize {
+		for _, size := range keeper.GetSwingStoreExportDataStats(ctx).Sizes {
+			if size.Kind == kind {
+				return size
+			}
+		}
+		return types.SwingStoreExportDataSize{Kind: kind}
+	}
+
+	err = keeper.UpdateSwingStoreExportData(ctx, []agoric.KVEntry{
+		agoric.NewKVEntry("kv.foo", "bar"),
+		agoric.NewKVEntry("kv.baz", "qux"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sizeOf("kv"), (types.SwingStoreExportDataSize{Kind: "kv", Entries: 2, Bytes: 18}); got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+
+	// alarms returns the EventSwingStoreExportDataAlarm emitted by update.
+	alarms := func(update func() error) []*types.EventSwingStoreExportDataAlarm {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		if err := update(); err != nil {
+			t.Fatal(err)
+		}
+		got := []*types.EventSwingStoreExportDataAlarm{}
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, msg.(*types.EventSwingStoreExportDataAlarm))
+		}
+		return got
+	}
+
+	// An invalid key is applied since the JS swing-store already has it, but
+	// raises an alarm
+	got := alarms(func() error {
+		return keeper.UpdateSwingStoreExportData(ctx, []agoric.KVEntry{agoric.NewKVEntry("foo.bar", "baz")})
+	})
+	wantAlarms := []*types.EventSwingStoreExportDataAlarm{{BlockWrittenBytes: 28, BlockBudget: 40, InvalidKeys: []string{"foo.bar"}}}
+	if !reflect.DeepEqual(got, wantAlarms) {
+		t.Errorf("got alarms %v, want %v", got, wantAlarms)
+	}
+	if value, _ := keeper.GetSwingStoreExportDataEntry(ctx, "foo.bar"); value != "baz" {
+		t.Errorf("update with invalid key was not applied: %q", value)
+	}
+	err = keeper.UpdateSwingStoreExportData(ctx, []agoric.KVEntry{agoric.NewKVEntryWithNoValue("foo.bar")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 35 of the 40 bytes of the block budget are used, so exceeding it raises an
+	// alarm, but the update is still applied
+	got = alarms(func() error {
+		return keeper.UpdateSwingStoreExportData(ctx, []agoric.KVEntry{agoric.NewKVEntry("kv.foo", "0123456789abcdefghij")})
+	})
+	wantAlarms = []*types.EventSwingStoreExportDataAlarm{{BlockWrittenBytes: 61, BlockBudget: 40, InvalidKeys: []string{}}}
+	if !reflect.DeepEqual(got, wantAlarms) {
+		t.Errorf("got alarms %v, want %v", got, wantAlarms)
+	}
+	if value, _ := keeper.GetSwingStoreExportDataEntry(ctx, "kv.foo"); value != "0123456789abcdefghij" {
+		t.Errorf("update over budget was not applied: %q", value)
+	}
+
+	// The usage is part of the state, so an update in a discarded context does
+	// not count
+	cacheCtx, _ := ctx.CacheContext()
+	if err := keeper.UpdateSwingStoreExportData(cacheCtx, []agoric.KVEntry{agoric.NewKVEntry("kv.x", "y")}); err != nil {
+		t.Fatal(err)
+	}
+	if written := keeper.getSwingStoreExportDataBlockWritten(ctx); written != 61 {
+		t.Errorf("got %d bytes written in the block, want 61", written)
+	}
+
+	// The budget is reset in the next block
+	ctx = ctx.WithBlockHeight(2)
+	got = alarms(func() error {
+		return keeper.UpdateSwingStoreExportData(ctx, []agoric.KVEntry{
+			agoric.NewKVEntry("kv.foo", "0123456789abcdefghij"),
+			agoric.NewKVEntryWithNoValue("kv.baz"),
+		})
+	})
+	if len(got) != 0 {
+		t.Errorf("got alarms %v, want none", got)
+	}
+	want := types.SwingStoreExportDataSize{Kind: "kv", Entries: 1, Bytes: 26}
+	if got := sizeOf("kv"); got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+
+	keeper.setSwingStoreExportDataStats(ctx, types.SwingStoreExportDataStats{})
+	keeper.RecomputeSwingStoreExportDataStats(ctx)
+	if got := sizeOf("kv"); got != want {
+		t.Errorf("got recomputed size %v, want %v", got, want)
+	}
+}
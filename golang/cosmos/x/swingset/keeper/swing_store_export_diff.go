@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// A differential swing-store export only contains the artifacts that are new
+// or changed since a base export, followed by a synthetic "delta" artifact
+// which records the height of the base export, and the names of the base
+// artifacts that are no longer part of the export (tombstones).
+// The "export data", if any, is always complete.
+//
+// A differential export cannot be imported by itself. It must first be merged
+// with its base using MergeSwingStoreExports, which results in a provider of
+// the complete export that can be restored.
+
+// SwingStoreExportDeltaArtifactName is the name of the synthetic artifact
+// terminating a differential export. Like UntrustedExportDataArtifactName, it
+// must not end up in the list of artifacts imported by the JS import tooling.
+const SwingStoreExportDeltaArtifactName = "DIFFERENTIAL-EXPORT-DELTA"
+
+// swingStoreExportDelta is the content of the synthetic delta artifact.
+type swingStoreExportDelta struct {
+	// BaseBlockHeight is the block height of the base export.
+	BaseBlockHeight uint64 `json:"baseBlockHeight"`
+	// Tombstones are the names of the base artifacts absent from the export.
+	Tombstones []string `json:"tombstones"`
+}
+
+// SwingStoreExportBase describes the artifacts of a complete export, against
+// which a differential export can be generated.
+type SwingStoreExportBase struct {
+	// BlockHeight is the block height of the base export.
+	BlockHeight uint64
+	// Artifacts maps the name of each artifact of the base export to the hex
+	// encoded sha256 of its content.
+	Artifacts map[string]string
+}
+
+// ReadSwingStoreExportBase consumes the artifacts of a provider to describe it
+// as the base of a differential export.
+func ReadSwingStoreExportBase(provider SwingStoreExportProvider) (*SwingStoreExportBase, error) {
+	base := &SwingStoreExportBase{
+		BlockHeight: provider.BlockHeight,
+		Artifacts:   make(map[string]string),
+	}
+
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			return base, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch artifact.Name {
+		case UntrustedExportDataArtifactName:
+			continue
+		case SwingStoreExportDeltaArtifactName:
+			return nil, errors.New("base export must not be a differential export")
+		}
+
+		base.Artifacts[artifact.Name] = sha256Hex(artifact.Data)
+	}
+}
+
+// NewDifferentialSwingStoreExportProvider wraps the provider of a complete
+// export into a provider of the differential export against base.
+func NewDifferentialSwingStoreExportProvider(provider SwingStoreExportProvider, base *SwingStoreExportBase) SwingStoreExportProvider {
+	seen := make(map[string]bool)
+	deltaProvided := false
+
+	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+		for {
+			if deltaProvided {
+				return artifact, io.EOF
+			}
+
+			artifact, err = provider.ReadNextArtifact()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return artifact, err
+			}
+
+			switch artifact.Name {
+			case UntrustedExportDataArtifactName:
+				return artifact, nil
+			case SwingStoreExportDeltaArtifactName:
+				return artifact, fmt.Errorf("unexpected export artifact name %s", artifact.Name)
+			}
+
+			seen[artifact.Name] = true
+			if baseHash, ok := base.Artifacts[artifact.Name]; ok && baseHash == sha256Hex(artifact.Data) {
+				continue
+			}
+			return artifact, nil
+		}
+
+		delta := swingStoreExportDelta{
+			BaseBlockHeight: base.BlockHeight,
+			Tombstones:      []string{},
+		}
+		for name := range base.Artifacts {
+			if !seen[name] {
+				delta.Tombstones = append(delta.Tombstones, name)
+			}
+		}
+		sort.Strings(delta.Tombstones)
+
+		deltaProvided = true
+		artifact.Name = SwingStoreExportDeltaArtifactName
+		artifact.Data, err = json.Marshal(delta)
+		return artifact, err
+	}
+
+	return SwingStoreExportProvider{
+		BlockHeight:         provider.BlockHeight,
+		GetExportDataReader: provider.GetExportDataReader,
+		ReadNextArtifact:    readNextArtifact,
+	}
+}
+
+// MergeSwingStoreExports combines a complete base export and a differential
+// export generated against it into a provider of the complete export at the
+// height of the differential export, suitable for RestoreExport.
+//
+// The artifacts of the differential export are provided first, followed by
+// the base artifacts which were neither replaced nor tombstoned. The "export
+// data" of the base export is ignored.
+func MergeSwingStoreExports(base SwingStoreExportProvider, delta SwingStoreExportProvider) SwingStoreExportProvider {
+	provided := make(map[string]bool)
+	var tombstones map[string]bool
+
+	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+		for tombstones == nil {
+			artifact, err = delta.ReadNextArtifact()
+			if err == io.EOF {
+				return artifact, errors.New("differential export is missing its delta artifact")
+			} else if err != nil {
+				return artifact, err
+			}
+
+			if artifact.Name != SwingStoreExportDeltaArtifactName {
+				provided[artifact.Name] = true
+				return artifact, nil
+			}
+
+			var exportDelta swingStoreExportDelta
+			err = json.Unmarshal(artifact.Data, &exportDelta)
+			if err != nil {
+				return types.SwingStoreArtifact{}, err
+			}
+			if exportDelta.BaseBlockHeight != base.BlockHeight {
+				return types.SwingStoreArtifact{}, fmt.Errorf("differential export base blockHeight (%d) doesn't match (%d)", exportDelta.BaseBlockHeight, base.BlockHeight)
+			}
+			tombstones = make(map[string]bool, len(exportDelta.Tombstones))
+			for _, name := range exportDelta.Tombstones {
+				tombstones[name] = true
+			}
+		}
+
+		for {
+			artifact, err = base.ReadNextArtifact()
+			if err != nil {
+				return artifact, err
+			}
+
+			switch {
+			case artifact.Name == SwingStoreExportDeltaArtifactName:
+				return types.SwingStoreArtifact{}, errors.New("base export must not be a differential export")
+			case artifact.Name == UntrustedExportDataArtifactName,
+				tombstones[artifact.Name],
+				provided[artifact.Name]:
+				continue
+			}
+			return artifact, nil
+		}
+	}
+
+	return SwingStoreExportProvider{
+		BlockHeight:         delta.BlockHeight,
+		GetExportDataReader: delta.GetExportDataReader,
+		ReadNextArtifact:    readNextArtifact,
+	}
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func TestDifferentialSwingStoreExport(t *testing.T) {
+	base, err := ReadSwingStoreExportBase(newTestExportProvider(nil, testArtifacts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(base.Artifacts) != len(testArtifacts) {
+		t.Fatalf("unexpected base artifacts %v", base.Artifacts)
+	}
+
+	current := []types.SwingStoreArtifact{
+		// unchanged
+		testArtifacts[1],
+		// changed
+		{Name: "transcript.v2.0.1", Data: []byte("other\n")},
+		// new
+		{Name: "transcript.v1.1.2", Data: []byte("item2\n")},
+	}
+	// transcript.v1.0.1 is removed
+
+	provider := newTestExportProvider(testExportData, current)
+	provider.BlockHeight = 43
+	delta := readAllArtifacts(t, NewDifferentialSwingStoreExportProvider(provider, base))
+
+	if len(delta) != 3 {
+		t.Fatalf("unexpected differential export artifacts %v", delta)
+	}
+	if !reflect.DeepEqual(delta[:2], current[1:]) {
+		t.Errorf("unexpected differential export artifacts %v", delta[:2])
+	}
+	if delta[2].Name != SwingStoreExportDeltaArtifactName {
+		t.Fatalf("expected delta artifact, got %s", delta[2].Name)
+	}
+	var exportDelta swingStoreExportDelta
+	if err := json.Unmarshal(delta[2].Data, &exportDelta); err != nil {
+		t.Fatal(err)
+	}
+	expectedDelta := swingStoreExportDelta{BaseBlockHeight: 42, Tombstones: []string{"transcript.v1.0.1"}}
+	if !reflect.DeepEqual(exportDelta, expectedDelta) {
+		t.Errorf("got delta %+v, expected %+v", exportDelta, expectedDelta)
+	}
+
+	deltaProvider := newTestExportProvider(testExportData, delta)
+	deltaProvider.BlockHeight = 43
+	merged := MergeSwingStoreExports(newTestExportProvider(nil, testArtifacts), deltaProvider)
+	if merged.BlockHeight != 43 {
+		t.Errorf("got merged blockHeight %d, expected 43", merged.BlockHeight)
+	}
+	if !reflect.DeepEqual(readAllExportData(t, merged), testExportData) {
+		t.Errorf("unexpected merged export data")
+	}
+	mergedArtifacts := readAllArtifacts(t, merged)
+	expectedArtifacts := []types.SwingStoreArtifact{current[1], current[2], current[0]}
+	if !reflect.DeepEqual(mergedArtifacts, expectedArtifacts) {
+		t.Errorf("got merged artifacts %v, expected %v", mergedArtifacts, expectedArtifacts)
+	}
+}
+
+func TestMergeSwingStoreExportsErrors(t *testing.T) {
+	deltaArtifact := func(baseBlockHeight uint64) types.SwingStoreArtifact {
+		return types.SwingStoreArtifact{
+			Name: SwingStoreExportDeltaArtifactName,
+			Data: []byte(mustMarshal(t, swingStoreExportDelta{BaseBlockHeight: baseBlockHeight, Tombstones: []string{}})),
+		}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		base  []types.SwingStoreArtifact
+		delta []types.SwingStoreArtifact
+	}{
+		{"missing delta", testArtifacts, testArtifacts[:1]},
+		{"wrong base height", testArtifacts, []types.SwingStoreArtifact{deltaArtifact(41)}},
+		{"differential base", []types.SwingStoreArtifact{deltaArtifact(1)}, []types.SwingStoreArtifact{deltaArtifact(42)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			merged := MergeSwingStoreExports(newTestExportProvider(nil, tc.base), newTestExportProvider(nil, tc.delta))
+			for {
+				_, err := merged.ReadNextArtifact()
+				if err != nil {
+					if err == io.EOF {
+						t.Fatal("expected merge error")
+					}
+					return
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package keeper
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+// This module verifies the artifacts of a swing-store export against trusted
+// swing-store "export data", like the one stored in the swingset module's
+// swingStore prefix, without involving the JS swing-store import logic.
+//
+// The "export data" contains the metadata of each artifact, from which the
+// verifier derives the list of artifacts required by an artifact mode, and the
+// hash each artifact must match. The hashing schemes mirror the ones used by
+// the JS swing-store import in packages/swing-store/src:
+// - transcript spans (transcriptStore.js): items are the lines of the
+//   artifact, and the span hash is a chain of SHA-256 hashes seeded with the
+//   hash of "start of transcript span".
+// - heap snapshots (snapStore.js): SHA-256 of the uncompressed artifact.
+// - bundles (bundleStore.js): the bundle ID itself is the hash. For "b0-"
+//   bundles it is the SHA-256 of the canonical JSON serialization of the
+//   bundle, and for "b1-" bundles the SHA-512 of the "compartment-map.json"
+//   file contained in the endoZipBase64 archive. The hashes of the individual
+//   modules of a "b1-" bundle are only checked by the JS import.
+
+// transcriptSpanInitialHash is the seed of transcript span hash chains, the
+// `initialHash` of transcriptStore.js
+var transcriptSpanInitialHash = sha256Hex([]byte("start of transcript span"))
+
+// transcriptSpanMetadata is the JSON "export data" value of a transcript span.
+type transcriptSpanMetadata struct {
+	VatID       string `json:"vatID"`
+	StartPos    uint64 `json:"startPos"`
+	EndPos      uint64 `json:"endPos"`
+	Hash        string `json:"hash"`
+	IsCurrent   int    `json:"isCurrent"`
+	Incarnation uint64 `json:"incarnation"`
+}
+
+// snapshotMetadata is the JSON "export data" value of a heap snapshot.
+type snapshotMetadata struct {
+	VatID   string `json:"vatID"`
+	SnapPos uint64 `json:"snapPos"`
+	Hash    string `json:"hash"`
+	InUse   int    `json:"inUse"`
+}
+
+// expectedArtifact is an artifact described by the "export data".
+type expectedArtifact struct {
+	// required indicates whether an export of the verified artifact mode must
+	// contain the artifact.
+	required bool
+	// verify checks the artifact data, returning a description of any problem.
+	verify func(data []byte) error
+}
+
+// SwingStoreArtifactProblem describes an artifact that failed verification.
+type SwingStoreArtifactProblem struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// SwingStoreExportVerification is the result of verifying the artifacts of a
+// swing-store export against "export data".
+type SwingStoreExportVerification struct {
+	// BlockHeight is the block height of the verified export.
+	BlockHeight uint64 `json:"blockHeight"`
+	// Verified lists the artifacts which matched their "export data".
+	Verified []string `json:"verified"`
+	// Missing lists the artifacts required by the artifact mode which were not
+	// part of the export.
+	Missing []string `json:"missing"`
+	// Extra lists the artifacts of the export which are not described by the
+	// "export data".
+	Extra []string `json:"extra"`
+	// Corrupt lists the artifacts of the export which did not match their
+	// "export data".
+	Corrupt []SwingStoreArtifactProblem `json:"corrupt"`
+}
+
+// IsValid tells whether the export had all the required artifacts and no
+// extra or corrupt artifacts.
+func (verification SwingStoreExportVerification) IsValid() bool {
+	return len(verification.Missing) == 0 &&
+		len(verification.Extra) == 0 &&
+		len(verification.Corrupt) == 0
+}
+
+// VerifySwingStoreExport consumes the artifacts of the provider, verifying
+// each of them against the metadata found in the trusted exportDataReader.
+// Artifacts required by artifactMode but absent from the export are reported
+// as missing. Since "debug" exports only contain artifacts which happen to be
+// available, no artifact is considered missing for that mode.
+//
+// An error is only returned if the export data or the export cannot be read.
+func VerifySwingStoreExport(exportDataReader agoric.KVEntryReader, provider SwingStoreExportProvider, artifactMode string) (SwingStoreExportVerification, error) {
+	verification := SwingStoreExportVerification{
+		BlockHeight: provider.BlockHeight,
+		Verified:    []string{},
+		Missing:     []string{},
+		Extra:       []string{},
+		Corrupt:     []SwingStoreArtifactProblem{},
+	}
+
+	if !isValidSwingStoreArtifactMode(artifactMode) {
+		return verification, fmt.Errorf("invalid artifact mode %q", artifactMode)
+	}
+
+	expected, err := expectedSwingStoreArtifacts(exportDataReader, artifactMode)
+	if err != nil {
+		return verification, err
+	}
+
+	seen := make(map[string]bool, len(expected))
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return verification, err
+		}
+
+		if artifact.Name == UntrustedExportDataArtifactName {
+			continue
+		}
+		if seen[artifact.Name] {
+			verification.Corrupt = append(verification.Corrupt, SwingStoreArtifactProblem{Name: artifact.Name, Reason: "duplicate artifact"})
+			continue
+		}
+		seen[artifact.Name] = true
+
+		expectedArtifact, found := expected[artifact.Name]
+		if !found {
+			verification.Extra = append(verification.Extra, artifact.Name)
+			continue
+		}
+
+		if err := expectedArtifact.verify(artifact.Data); err != nil {
+			verification.Corrupt = append(verification.Corrupt, SwingStoreArtifactProblem{Name: artifact.Name, Reason: err.Error()})
+			continue
+		}
+		verification.Verified = append(verification.Verified, artifact.Name)
+	}
+
+	for name, expectedArtifact := range expected {
+		if expectedArtifact.required && !seen[name] {
+			verification.Missing = append(verification.Missing, name)
+		}
+	}
+	sort.Strings(verification.Missing)
+
+	return verification, nil
+}
+
+// RequiredSwingStoreArtifacts reads the "export data" and returns the sorted
+// names of the artifacts that an export in the given artifact mode must contain.
+func RequiredSwingStoreArtifacts(exportDataReader agoric.KVEntryReader, artifactMode string) ([]string, error) {
+	expected, err := expectedSwingStoreArtifacts(exportDataReader, artifactMode)
+	if err != nil {
+		return nil, err
+	}
+
+	required := []string{}
+	for name, expectedArtifact := range expected {
+		if expectedArtifact.required {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+	return required, nil
+}
+
+// expectedSwingStoreArtifacts reads the "export data" and returns the
+// artifacts it describes, keyed by artifact name.
+func expectedSwingStoreArtifacts(exportDataReader agoric.KVEntryReader, artifactMode string) (map[string]expectedArtifact, error) {
+	defer exportDataReader.Close()
+
+	expected := map[string]expectedArtifact{}
+	spans := []transcriptSpanMetadata{}
+	currentIncarnations := map[string]uint64{}
+
+	for {
+		entry, err := exportDataReader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if !entry.HasValue() {
+			continue
+		}
+
+		key := entry.Key()
+		value := entry.StringValue()
+		switch strings.SplitN(key, ".", 2)[0] {
+		case "transcript":
+			var span transcriptSpanMetadata
+			if err := json.Unmarshal([]byte(value), &span); err != nil {
+				return nil, fmt.Errorf("invalid transcript span metadata %s: %w", key, err)
+			}
+			if span.IsCurrent != 0 {
+				currentIncarnations[span.VatID] = span.Incarnation
+			}
+			spans = append(spans, span)
+
+		case "snapshot":
+			if strings.HasSuffix(key, ".current") {
+				// points to the name of the current snapshot artifact
+				continue
+			}
+			var snapshot snapshotMetadata
+			if err := json.Unmarshal([]byte(value), &snapshot); err != nil {
+				return nil, fmt.Errorf("invalid snapshot metadata %s: %w", key, err)
+			}
+			name := fmt.Sprintf("snapshot.%s.%d", snapshot.VatID, snapshot.SnapPos)
+			expected[name] = expectedArtifact{
+				required: snapshot.InUse != 0 && artifactMode != SwingStoreArtifactModeNone && artifactMode != SwingStoreArtifactModeDebug,
+				verify:   makeSnapshotVerifier(snapshot.Hash),
+			}
+
+		case "bundle":
+			expected[key] = expectedArtifact{
+				required: artifactMode != SwingStoreArtifactModeNone && artifactMode != SwingStoreArtifactModeDebug,
+				verify:   makeBundleVerifier(value),
+			}
+		}
+	}
+
+	for _, span := range spans {
+		name := fmt.Sprintf("transcript.%s.%d.%d", span.VatID, span.StartPos, span.EndPos)
+		var required bool
+		switch artifactMode {
+		case SwingStoreArtifactModeOperational:
+			required = span.IsCurrent != 0
+		case SwingStoreArtifactModeReplay:
+			incarnation, found := currentIncarnations[span.VatID]
+			required = found && span.Incarnation == incarnation
+		case SwingStoreArtifactModeArchival:
+			required = true
+		}
+		expected[name] = expectedArtifact{
+			required: required,
+			verify:   makeTranscriptSpanVerifier(span),
+		}
+	}
+
+	return expected, nil
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func sha512Hex(data []byte) string {
+	hash := sha512.Sum512(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// makeTranscriptSpanVerifier verifies a transcript span artifact, which is
+// made of one line terminated item per transcript entry.
+func makeTranscriptSpanVerifier(span transcriptSpanMetadata) func(data []byte) error {
+	return func(data []byte) error {
+		lines := strings.Split(string(data), "\n")
+		if lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		if uint64(len(lines)) != span.EndPos-span.StartPos {
+			return fmt.Errorf("span has %d items, metadata says %d", len(lines), span.EndPos-span.StartPos)
+		}
+
+		hash := transcriptSpanInitialHash
+		for _, line := range lines {
+			// like the JS String.prototype.trimEnd
+			item := strings.TrimRightFunc(line, func(r rune) bool {
+				return unicode.IsSpace(r) || r == '\uFEFF'
+			})
+			hash = sha256Hex([]byte(hash + sha256Hex([]byte(item))))
+		}
+		if hash != span.Hash {
+			return fmt.Errorf("hash is %s, metadata says %s", hash, span.Hash)
+		}
+		return nil
+	}
+}
+
+// makeSnapshotVerifier verifies an uncompressed heap snapshot artifact.
+func makeSnapshotVerifier(expectedHash string) func(data []byte) error {
+	return func(data []byte) error {
+		if hash := sha256Hex(data); hash != expectedHash {
+			return fmt.Errorf("hash is %s, metadata says %s", hash, expectedHash)
+		}
+		return nil
+	}
+}
+
+// makeBundleVerifier verifies a bundle artifact against its bundle ID.
+func makeBundleVerifier(bundleID string) func(data []byte) error {
+	return func(data []byte) error {
+		var hash string
+		switch {
+		case strings.HasPrefix(bundleID, "b0-"):
+			var bundle struct {
+				ModuleFormat json.RawMessage `json:"moduleFormat"`
+				Source       json.RawMessage `json:"source"`
+				SourceMap    json.RawMessage `json:"sourceMap"`
+			}
+			if err := json.Unmarshal(data, &bundle); err != nil {
+				return fmt.Errorf("invalid bundle: %w", err)
+			}
+			serialized, err := serializeNestedEvaluateBundle(bundle.ModuleFormat, bundle.Source, bundle.SourceMap)
+			if err != nil {
+				return fmt.Errorf("invalid bundle: %w", err)
+			}
+			hash = "b0-" + sha256Hex(serialized)
+
+		case strings.HasPrefix(bundleID, "b1-"):
+			archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				return fmt.Errorf("invalid bundle archive: %w", err)
+			}
+			compartmentMap, err := archive.Open("compartment-map.json")
+			if err != nil {
+				return fmt.Errorf("invalid bundle archive: %w", err)
+			}
+			defer compartmentMap.Close()
+			compartmentMapBytes, err := io.ReadAll(compartmentMap)
+			if err != nil {
+				return fmt.Errorf("invalid bundle archive: %w", err)
+			}
+			hash = "b1-" + sha512Hex(compartmentMapBytes)
+
+		default:
+			return fmt.Errorf("unsupported bundle ID %s", bundleID)
+		}
+
+		if hash != bundleID {
+			return fmt.Errorf("bundle ID is %s, metadata says %s", hash, bundleID)
+		}
+		return nil
+	}
+}
+
+// serializeNestedEvaluateBundle reproduces the JSON.stringify serialization
+// of the harden({ moduleFormat, source, sourceMap }) record used by the JS
+// bundle store, omitting absent properties. All properties of such bundles
+// are strings.
+func serializeNestedEvaluateBundle(moduleFormat, source, sourceMap json.RawMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for _, prop := range []struct {
+		name  string
+		value json.RawMessage
+	}{{"moduleFormat", moduleFormat}, {"source", source}, {"sourceMap", sourceMap}} {
+		if prop.value == nil {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(prop.value, &value); err != nil {
+			return nil, fmt.Errorf("property %s: %w", prop.name, err)
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeJSONStringifiedString(&buf, prop.name)
+		buf.WriteByte(':')
+		writeJSONStringifiedString(&buf, value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeJSONStringifiedString writes a string as serialized by JSON.stringify,
+// which unlike encoding/json does not escape HTML characters or the U+2028
+// and U+2029 line terminators.
+func writeJSONStringifiedString(buf *bytes.Buffer, value string) {
+	buf.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func spanHash(items ...string) string {
+	hash := transcriptSpanInitialHash
+	for _, item := range items {
+		hash = sha256Hex([]byte(hash + sha256Hex([]byte(item))))
+	}
+	return hash
+}
+
+func mustMarshal(t *testing.T, value interface{}) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bz)
+}
+
+func newSliceExportProvider(artifacts []types.SwingStoreArtifact) SwingStoreExportProvider {
+	next := 0
+	return SwingStoreExportProvider{
+		BlockHeight: 42,
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if next >= len(artifacts) {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			next++
+			return artifacts[next-1], nil
+		},
+	}
+}
+
+func TestVerifySwingStoreExport(t *testing.T) {
+	oldSpan := `{"d":"old1"}` + "\n" + `{"d":"old2"}` + "\n"
+	currentSpan := `{"d":"new1"}` + "\n"
+	snapshot := []byte("heap snapshot")
+
+	var zipBuf bytes.Buffer
+	zipWriter := zip.NewWriter(&zipBuf)
+	compartmentMap := []byte(`{"entry":{}}`)
+	w, err := zipWriter.Create("compartment-map.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(compartmentMap); err != nil {
+		t.Fatal(err)
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	b1Bundle := zipBuf.Bytes()
+	b1BundleID := "b1-" + sha512Hex(compartmentMap)
+
+	b0Bundle := []byte(`{"sourceMap":"//# map","moduleFormat":"nestedEvaluate","source":"function getExport() { return '<&>'; }"}`)
+	b0BundleID := "b0-" + sha256Hex([]byte(`{"moduleFormat":"nestedEvaluate","source":"function getExport() { return '<&>'; }","sourceMap":"//# map"}`))
+
+	exportData := func() agoric.KVEntryReader {
+		return agoric.NewJsonRawMessageKVEntriesReader([]json.RawMessage{
+			json.RawMessage(`["kv.foo", "bar"]`),
+			json.RawMessage(mustMarshal(t, []string{"transcript.v1.0", mustMarshal(t, transcriptSpanMetadata{
+				VatID: "v1", StartPos: 0, EndPos: 2, Hash: spanHash(`{"d":"old1"}`, `{"d":"old2"}`), Incarnation: 0,
+			})})),
+			json.RawMessage(mustMarshal(t, []string{"transcript.v1.current", mustMarshal(t, transcriptSpanMetadata{
+				VatID: "v1", StartPos: 2, EndPos: 3, Hash: spanHash(`{"d":"new1"}`), IsCurrent: 1, Incarnation: 1,
+			})})),
+			json.RawMessage(mustMarshal(t, []string{"snapshot.v1.2", mustMarshal(t, snapshotMetadata{
+				VatID: "v1", SnapPos: 2, Hash: sha256Hex(snapshot), InUse: 1,
+			})})),
+			json.RawMessage(mustMarshal(t, []string{"snapshot.v1.current", "snapshot.v1.2"})),
+			json.RawMessage(mustMarshal(t, []string{"bundle." + b0BundleID, b0BundleID})),
+			json.RawMessage(mustMarshal(t, []string{"bundle." + b1BundleID, b1BundleID})),
+		})
+	}
+
+	complete := []types.SwingStoreArtifact{
+		{Name: "snapshot.v1.2", Data: snapshot},
+		{Name: "transcript.v1.0.2", Data: []byte(oldSpan)},
+		{Name: "transcript.v1.2.3", Data: []byte(currentSpan)},
+		{Name: "bundle." + b0BundleID, Data: b0Bundle},
+		{Name: "bundle." + b1BundleID, Data: b1Bundle},
+	}
+
+	verification, err := VerifySwingStoreExport(exportData(), newSliceExportProvider(complete), SwingStoreArtifactModeArchival)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verification.IsValid() || len(verification.Verified) != len(complete) {
+		t.Errorf("complete archival export should be valid, got %+v", verification)
+	}
+
+	// The operational artifact mode does not require the old span.
+	operational := append([]types.SwingStoreArtifact{}, complete[0])
+	operational = append(operational, complete[2:]...)
+	verification, err = VerifySwingStoreExport(exportData(), newSliceExportProvider(operational), SwingStoreArtifactModeOperational)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verification.IsValid() {
+		t.Errorf("operational export should be valid, got %+v", verification)
+	}
+
+	verification, err = VerifySwingStoreExport(exportData(), newSliceExportProvider(operational), SwingStoreArtifactModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verification.IsValid() {
+		t.Errorf("replay export of the current incarnation should be valid, got %+v", verification)
+	}
+
+	verification, err = VerifySwingStoreExport(exportData(), newSliceExportProvider(operational), SwingStoreArtifactModeArchival)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"transcript.v1.0.2"}; !reflect.DeepEqual(verification.Missing, want) {
+		t.Errorf("got missing %q, want %q", verification.Missing, want)
+	}
+
+	broken := []types.SwingStoreArtifact{
+		{Name: "snapshot.v1.2", Data: []byte("tampered")},
+		{Name: "transcript.v1.0.2", Data: []byte(`{"d":"old1"}` + "\n")},
+		{Name: "transcript.v1.2.3", Data: []byte(`{"d":"bad1"}` + "\n")},
+		{Name: "transcript.v2.0.1", Data: []byte(`{"d":"extra"}` + "\n")},
+		{Name: "bundle." + b0BundleID, Data: []byte(`{"moduleFormat":"nestedEvaluate","source":"other"}`)},
+	}
+	verification, err = VerifySwingStoreExport(exportData(), newSliceExportProvider(broken), SwingStoreArtifactModeOperational)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verification.IsValid() {
+		t.Error("broken export should not be valid")
+	}
+	corrupt := []string{}
+	for _, problem := range verification.Corrupt {
+		corrupt = append(corrupt, problem.Name)
+	}
+	if want := []string{"snapshot.v1.2", "transcript.v1.0.2", "transcript.v1.2.3", "bundle." + b0BundleID}; !reflect.DeepEqual(corrupt, want) {
+		t.Errorf("got corrupt %q, want %q", corrupt, want)
+	}
+	if want := []string{"transcript.v2.0.1"}; !reflect.DeepEqual(verification.Extra, want) {
+		t.Errorf("got extra %q, want %q", verification.Extra, want)
+	}
+	if want := []string{"bundle." + b1BundleID}; !reflect.DeepEqual(verification.Missing, want) {
+		t.Errorf("got missing %q, want %q", verification.Missing, want)
+	}
+}
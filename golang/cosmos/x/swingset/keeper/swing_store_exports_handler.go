@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sync"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
@@ -41,8 +44,8 @@ import (
 //   happens before the generated swing-store export can be consumed.
 //
 // The general approach taken is to implement a SwingStoreExportsHandler that
-// implements the communication with the JS side, enforces that no concurrent
-// operations take place, defers the consumption of the export to a provided
+// implements the communication with the JS side, queues operations so that no
+// concurrent operations take place, defers the consumption of the export to a provided
 // SwingStoreExportEventHandler, and provides some synchronization methods to
 // let the application enforce mutation boundaries.
 //
@@ -50,16 +53,18 @@ import (
 // calls should be performed from the same goroutine (no mutex enforcement).
 //
 // The process of generating a SwingStore export proceeds as follow:
-// - The component invokes swingStoreExportsHandler.InitiateExport with an
-//   eventHandler for the export.
-// - InitiateExport verifies no other export operation is in progress and
-//   starts a goroutine to perform the export operation. It requests the JS
-//   side to start generating an export of the swing-store, and calls the
-//   eventHandler's OnExportStarted method with a function param allowing it to
-//   retrieve the export.
+// - The component invokes swingStoreExportsHandler.QueueExport (or
+//   InitiateExport) with an eventHandler for the export.
+// - QueueExport adds the operation to the queue of operations and starts a
+//   goroutine to perform the export operation. Once all previously queued
+//   operations are done, the goroutine requests the JS side to start
+//   generating an export of the swing-store, and calls the eventHandler's
+//   OnExportStarted method with a function param allowing it to retrieve the
+//   export. An operation can be cancelled until then.
 // - The cosmos app will call WaitUntilSwingStoreExportStarted before
 //   instructing the JS controller to commit its work, satisfying the
-//   deterministic exports requirement.
+//   deterministic exports requirement. This waits for all queued exports to
+//   have started, which may require waiting for previous operations to be done.
 // - OnExportStarted must call the retrieve function before returning, however
 //   it may perform other work before. For cosmos state-sync snapshots,
 //   OnExportStarted will call app.Snapshot which will invoke the swingset
@@ -180,6 +185,34 @@ const (
 	SwingStoreArtifactModeDebug = "debug"
 )
 
+const (
+	// SwingStoreExportLayoutFlat names the file of each artifact after its
+	// position in the export and its sanitized name.
+	SwingStoreExportLayoutFlat = "flat"
+
+	// SwingStoreExportLayoutContentAddressed names the file of each artifact
+	// after the SHA-256 hash of its content, in an "objects" sub-directory.
+	// Unchanged artifacts keep the same filename across exports, which lets
+	// incremental backups share them.
+	SwingStoreExportLayoutContentAddressed = "content-addressed"
+)
+
+// contentAddressedObjectsDir is the sub-directory holding the artifacts of an
+// export using the content-addressed layout.
+const contentAddressedObjectsDir = "objects"
+
+// contentAddressedManifestsDir is the sub-directory holding the manifest and
+// export data of each export written to a directory using the
+// content-addressed layout, named after the block height of the export.
+const contentAddressedManifestsDir = "manifests"
+
+// contentAddressedManifestPath returns the slash separated path, relative to
+// an export directory using the content-addressed layout, of the manifest of
+// the export at blockHeight.
+func contentAddressedManifestPath(blockHeight uint64) string {
+	return path.Join(contentAddressedManifestsDir, fmt.Sprintf("%d.json", blockHeight))
+}
+
 const (
 	// SwingStoreExportDataModeSkip indicates "export data" should be excluded from
 	// an export. ArtifactMode cannot be "none" in this case.
@@ -207,6 +240,13 @@ type SwingStoreExportOptions struct {
 	// SwingStoreExportDataModeAll. If "skip", the reader returned by
 	// SwingStoreExportProvider's GetExportDataReader will be nil.
 	ExportDataMode string `json:"exportDataMode,omitempty"`
+	// Base, if not nil, selects a differential export against the base export
+	// it describes. Only the artifacts that are new or changed since the base
+	// are provided, followed by a synthetic artifact listing the base artifacts
+	// that are no longer part of the export.
+	// The JS side always generates a complete export, which is filtered when
+	// retrieved. See NewDifferentialSwingStoreExportProvider.
+	Base *SwingStoreExportBase `json:"-"`
 }
 
 // SwingStoreRestoreOptions are configurable options provided to the JS swing-store import
@@ -224,6 +264,65 @@ type SwingStoreRestoreOptions struct {
 	ExportDataMode string `json:"exportDataMode,omitempty"`
 }
 
+// isValidSwingStoreArtifactMode tells whether mode is one of the
+// SwingStoreArtifactMode* const values.
+func isValidSwingStoreArtifactMode(mode string) bool {
+	switch mode {
+	case SwingStoreArtifactModeNone,
+		SwingStoreArtifactModeOperational,
+		SwingStoreArtifactModeReplay,
+		SwingStoreArtifactModeArchival,
+		SwingStoreArtifactModeDebug:
+		return true
+	}
+	return false
+}
+
+// isValidSwingStoreExportLayout tells whether layout is one of the
+// SwingStoreExportLayout* const values.
+func isValidSwingStoreExportLayout(layout string) bool {
+	return layout == SwingStoreExportLayoutFlat || layout == SwingStoreExportLayoutContentAddressed
+}
+
+// ValidateSwingStoreExportOptions checks that the export options are a
+// combination supported by the JS swing-store export.
+func ValidateSwingStoreExportOptions(exportOptions SwingStoreExportOptions) error {
+	if !isValidSwingStoreArtifactMode(exportOptions.ArtifactMode) {
+		return fmt.Errorf("invalid artifact mode %q", exportOptions.ArtifactMode)
+	}
+	switch exportOptions.ExportDataMode {
+	case SwingStoreExportDataModeSkip:
+		if exportOptions.ArtifactMode == SwingStoreArtifactModeNone {
+			return fmt.Errorf("artifact mode %q cannot be used with export data mode %q", exportOptions.ArtifactMode, exportOptions.ExportDataMode)
+		}
+	case SwingStoreExportDataModeAll:
+	default:
+		return fmt.Errorf("invalid export data mode %q for export", exportOptions.ExportDataMode)
+	}
+	return nil
+}
+
+// ValidateSwingStoreRestoreOptions checks that the restore options are a
+// combination supported by the JS swing-store import.
+func ValidateSwingStoreRestoreOptions(restoreOptions SwingStoreRestoreOptions) error {
+	if !isValidSwingStoreArtifactMode(restoreOptions.ArtifactMode) {
+		return fmt.Errorf("invalid artifact mode %q", restoreOptions.ArtifactMode)
+	}
+	switch restoreOptions.ExportDataMode {
+	case SwingStoreExportDataModeRepairMetadata:
+		if restoreOptions.ArtifactMode != SwingStoreArtifactModeNone {
+			return fmt.Errorf("artifact mode must be %q with export data mode %q", SwingStoreArtifactModeNone, restoreOptions.ExportDataMode)
+		}
+	case SwingStoreExportDataModeAll:
+		if restoreOptions.ArtifactMode == SwingStoreArtifactModeNone {
+			return fmt.Errorf("artifact mode %q cannot be used with export data mode %q", restoreOptions.ArtifactMode, restoreOptions.ExportDataMode)
+		}
+	default:
+		return fmt.Errorf("invalid export data mode %q for restore", restoreOptions.ExportDataMode)
+	}
+	return nil
+}
+
 type swingStoreImportOptions struct {
 	// ExportDir is the directory created by RestoreExport that JS swing-store
 	// should import from.
@@ -253,13 +352,18 @@ type operationDetails struct {
 	// logger is the destination for this operation's log messages.
 	// It is assigned at creation and never mutated.
 	logger log.Logger
-	// exportStartedResult is used to synchronize the commit boundary by the
+	// previous is the operation queued before this one, if any. This operation
+	// only starts once the previous operation is done.
+	// It is assigned at creation and never mutated.
+	previous *operationDetails
+	// exportStarted is used to synchronize the commit boundary by the
 	// component performing the export operation to ensure export determinism.
 	// unused for restore operations
 	// It is assigned at creation and never mutated. The started goroutine
-	// writes into the channel and closes it. The main goroutine reads from the
-	// channel.
-	exportStartedResult chan error
+	// assigns startErr then closes the channel. The main goroutine reads
+	// startErr once the channel is closed.
+	exportStarted chan struct{}
+	startErr      error
 	// exportRetrieved is an internal flag indicating whether the JS generated
 	// export was retrieved. It can be false regardless of the component's
 	// eventHandler reporting an error or not. It is only indicative of whether
@@ -268,118 +372,251 @@ type operationDetails struct {
 	// un-retrieved export.
 	// It is only read or written by the export operation's goroutine.
 	exportRetrieved bool
-	// exportDone is a channel that is closed when the active export operation
-	// is complete.
-	// It is assigned at creation and never mutated. The started goroutine
-	// writes into the channel and closes it. The main goroutine reads from the
-	// channel.
-	exportDone chan error
-}
-
-// activeOperation is a global variable reflecting a swing-store import or
-// export in progress on the JS side.
-// This variable is only assigned to through calls of the public methods of
-// SwingStoreExportsHandler, which rely on the exportDone channel getting
-// closed to nil this variable.
-// Only the calls to InitiateExport and RestoreExport set this to a non-nil
-// value. The goroutine in which these calls occur is referred to as the
+	// exportDone is a channel that is closed when the operation is complete,
+	// which is never before the previous operation is done.
+	// It is assigned at creation and never mutated. The operation assigns
+	// doneErr then closes the channel. The main goroutine reads doneErr once
+	// the channel is closed.
+	exportDone chan struct{}
+	doneErr    error
+	// errReported indicates whether an error of this operation was already
+	// reported by WaitUntilSwingStoreExportStarted or
+	// WaitUntilSwingStoreExportDone.
+	// It is only read or written by the main goroutine.
+	errReported bool
+	// mu guards state, which the main goroutine writes to cancel the operation
+	// while the operation's goroutine writes it to start the operation.
+	mu    sync.Mutex
+	state operationState
+	// cancelled is closed when the operation is cancelled before starting.
+	cancelled chan struct{}
+}
+
+type operationState int
+
+const (
+	operationQueued operationState = iota
+	operationStarted
+	operationCancelled
+)
+
+// errSwingStoreOperationCancelled is the error reported by an operation
+// cancelled before it started.
+var errSwingStoreOperationCancelled = errors.New("swing-store export operation cancelled")
+
+// start transitions a queued operation to started, unless it was cancelled.
+func (operationDetails *operationDetails) start() bool {
+	operationDetails.mu.Lock()
+	defer operationDetails.mu.Unlock()
+	if operationDetails.state == operationCancelled {
+		return false
+	}
+	operationDetails.state = operationStarted
+	return true
+}
+
+// isDone returns whether the operation is complete, without blocking.
+func (operationDetails *operationDetails) isDone() bool {
+	select {
+	case <-operationDetails.exportDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitForPrevious blocks until the previous operation, if any, is done.
+// It returns false if the operation was cancelled while waiting.
+func (operationDetails *operationDetails) waitForPrevious() bool {
+	if operationDetails.previous == nil {
+		return true
+	}
+	select {
+	case <-operationDetails.previous.exportDone:
+		return true
+	case <-operationDetails.cancelled:
+		return false
+	}
+}
+
+// operationQueue holds the swing-store import or export operations which have
+// been queued and are not known to be done yet, in order. The JS side only
+// supports a single operation at a time, so each operation only starts once
+// the previous one is done.
+// The queue is only accessed through calls of the public methods of
+// SwingStoreExportsHandler and SwingStoreExportOperation. Only the calls to
+// QueueExport (or InitiateExport) and RestoreExport add operations to the
+// queue. The goroutine in which these calls occur is referred to as the
 // "main goroutine". That goroutine may be different over time, but it's the
 // caller's responsibility to ensure those goroutines do not overlap calls to
-// the SwingStoreExportsHandler public methods.
-// See also the details of each field for the conditions under which they are
-// accessed.
-var activeOperation *operationDetails
+// the public methods.
+type operationQueue struct {
+	operations []*operationDetails
+}
+
+// last returns the last operation of the queue, if any.
+func (queue *operationQueue) last() *operationDetails {
+	if len(queue.operations) == 0 {
+		return nil
+	}
+	return queue.operations[len(queue.operations)-1]
+}
+
+// prune removes the operations which are done from the front of the queue.
+// Since an operation is never done before the previous one, these are all
+// the done operations.
+func (queue *operationQueue) prune() {
+	for len(queue.operations) > 0 && queue.operations[0].isDone() {
+		queue.operations[0] = nil
+		queue.operations = queue.operations[1:]
+	}
+}
+
+// SwingStoreExportOperation is a handle on a swing-store export operation
+// queued by QueueExport.
+// Its methods must be called by the main goroutine.
+type SwingStoreExportOperation struct {
+	queue   *operationQueue
+	details *operationDetails
+}
+
+// QueuePosition returns the number of operations which must complete before
+// this operation starts, 0 if the operation already started, or -1 if the
+// operation is done or was cancelled.
+func (operation *SwingStoreExportOperation) QueuePosition() int {
+	operation.queue.prune()
+	position := 0
+	for _, operationDetails := range operation.queue.operations {
+		operationDetails.mu.Lock()
+		state := operationDetails.state
+		operationDetails.mu.Unlock()
+		if operationDetails == operation.details {
+			switch state {
+			case operationCancelled:
+				return -1
+			case operationStarted:
+				return 0
+			}
+			return position
+		}
+		if state != operationCancelled {
+			position++
+		}
+	}
+	return -1
+}
+
+// Cancel cancels the operation if it has not started yet. The operation's
+// event handler is then never invoked. It is an error to cancel an operation
+// which already started.
+func (operation *SwingStoreExportOperation) Cancel() error {
+	operationDetails := operation.details
+	operationDetails.mu.Lock()
+	defer operationDetails.mu.Unlock()
+	switch operationDetails.state {
+	case operationStarted:
+		return fmt.Errorf("export operation for height %d already started", operationDetails.blockHeight)
+	case operationCancelled:
+		return nil
+	}
+	operationDetails.state = operationCancelled
+	// The cancellation was requested, so there is no need to report it
+	operationDetails.errReported = true
+	close(operationDetails.cancelled)
+	return nil
+}
+
+// WaitUntilStarted blocks until the operation has started, or failed to start,
+// and returns any start error. Like WaitUntilSwingStoreExportStarted, it may
+// block until all the operations queued before this one are done.
+func (operation *SwingStoreExportOperation) WaitUntilStarted() error {
+	<-operation.details.exportStarted
+	return operation.details.startErr
+}
 
-// WaitUntilSwingStoreExportStarted synchronizes with an export operation in
-// progress, if any.
+// WaitUntilDone blocks until the operation is done, and returns any error that
+// occurred during the operation. Once this method returns, the
+// SwingStoreExportEventHandler provided for the operation is no longer in use.
+func (operation *SwingStoreExportOperation) WaitUntilDone() error {
+	<-operation.details.exportDone
+	return operation.details.doneErr
+}
+
+// WaitUntilSwingStoreExportStarted synchronizes with the export operations in
+// progress or queued, if any.
 // The JS swing-store export must have started before a new block is committed
 // to ensure the content of the export is the one expected. The app must call
 // this method before sending a commit action to the JS controller.
 //
-// Waits for a just initiated export operation to have started in its goroutine.
+// Waits for all the initiated export operations to have started in their
+// goroutine. Since operations are performed one at a time, this blocks until
+// the operations queued before the last one are done.
+// Reports the first start error not previously reported, if any.
 // If no operation is in progress (InitiateExport hasn't been called or
-// already completed), or if we previously checked if the operation had started,
-// returns immediately.
+// all operations already completed), returns immediately.
 //
 // Must be called by the main goroutine
-func WaitUntilSwingStoreExportStarted() error {
-	operationDetails := activeOperation
-	if operationDetails == nil {
-		return nil
+func (exportsHandler SwingStoreExportsHandler) WaitUntilSwingStoreExportStarted() error {
+	var startErr error
+	for _, operationDetails := range exportsHandler.queue.operations {
+		if operationDetails.isRestore {
+			continue
+		}
+		// Block until the operation has started, or failed to start.
+		// Only the first call after an export was initiated will report an error.
+		<-operationDetails.exportStarted
+		if operationDetails.startErr != nil && !operationDetails.errReported {
+			operationDetails.errReported = true
+			if startErr == nil {
+				startErr = operationDetails.startErr
+			} else {
+				operationDetails.logger.Error("swing-store export failed to start", "err", operationDetails.startErr)
+			}
+		}
 	}
-	// Block until the active operation has started, saving the result.
-	// The operation's goroutine only produces a value in case of an error,
-	// and closes the channel once the export has started or failed.
-	// Only the first call after an export was initiated will report an error.
-	startErr := <-operationDetails.exportStartedResult
 
-	// Check if the active export operation is done, and if so, nil it out so
-	// future calls are faster.
-	select {
-	case <-operationDetails.exportDone:
-		// If there was a start error, the channel is already closed at this point.
-		activeOperation = nil
-	default:
-		// don't wait for it to finish
-		// If there is no start error, the operation may take an arbitrary amount
-		// of time to terminate, likely spanning multiple blocks. However this
-		// function will only ever observe the expected activeOperation since the
-		// internal checkNotActive() called immediately on InitiateSnapshot will
-		// nil-out activeOperation if a stale value was sill sitting around.
-	}
+	// Remove the operations which are done so future calls are faster.
+	// If there is no start error, an operation may take an arbitrary amount of
+	// time to terminate, likely spanning multiple blocks, and we don't wait for
+	// it to finish.
+	exportsHandler.queue.prune()
 
 	return startErr
 }
 
-// WaitUntilSwingStoreExportDone synchronizes with the completion of an export
-// operation in progress, if any.
-// Only a single swing-store operation may execute at a time. Calling
-// InitiateExport or RestoreExport will fail if a swing-store operation is
-// already in progress. Furthermore, a component may need to know once an
-// export it initiated has completed. Once this method call returns, the
-// goroutine is guaranteed to have terminated, and the SwingStoreExportEventHandler
-// provided to InitiateExport to no longer be in use.
+// WaitUntilSwingStoreExportDone synchronizes with the completion of the export
+// operations in progress or queued, if any.
+// Only a single swing-store operation may execute at a time, and operations
+// initiated while another is in progress are queued. A component may need to
+// know once the exports have completed. Once this method call returns, the
+// goroutines are guaranteed to have terminated, and the
+// SwingStoreExportEventHandler provided to InitiateExport to no longer be in use.
+// Use SwingStoreExportOperation's WaitUntilDone to wait for a single operation.
 //
-// Reports any error that may have occurred from InitiateExport.
+// Reports the first error not previously reported that occurred during the
+// operations, if any.
 // If no export operation is in progress (InitiateExport hasn't been called or
-// already completed), or if we previously checked if an export had completed,
-// returns immediately.
+// already completed), returns immediately.
 //
 // Must be called by the main goroutine
-func WaitUntilSwingStoreExportDone() error {
-	operationDetails := activeOperation
-	if operationDetails == nil {
-		return nil
-	}
-	// Block until the active export has completed.
-	// The export operation's goroutine only produces a value in case of an error,
-	// and closes the channel once the export has completed or failed.
-	// Only the first call after an export was initiated will report an error.
-	exportErr := <-operationDetails.exportDone
-	activeOperation = nil
-
-	return exportErr
-}
-
-// checkNotActive returns an error if there is an active operation.
-//
-// Always internally called by the main goroutine
-func checkNotActive() error {
-	operationDetails := activeOperation
-	if operationDetails != nil {
-		select {
-		case <-operationDetails.exportDone:
-			// nil-out any stale operation
-			activeOperation = nil
-		default:
-			if operationDetails.isRestore {
-				return fmt.Errorf("restore operation already in progress for height %d", operationDetails.blockHeight)
+func (exportsHandler SwingStoreExportsHandler) WaitUntilSwingStoreExportDone() error {
+	var exportErr error
+	for _, operationDetails := range exportsHandler.queue.operations {
+		// Block until the operation has completed.
+		// Only the first call after an export was initiated will report an error.
+		<-operationDetails.exportDone
+		if operationDetails.doneErr != nil && !operationDetails.errReported {
+			operationDetails.errReported = true
+			if exportErr == nil {
+				exportErr = operationDetails.doneErr
 			} else {
-				return fmt.Errorf("export operation already in progress for height %d", operationDetails.blockHeight)
+				operationDetails.logger.Error("swing-store export failed", "err", operationDetails.doneErr)
 			}
 		}
 	}
-	return nil
+	exportsHandler.queue.prune()
+
+	return exportErr
 }
 
 // SwingStoreExportProvider gives access to a SwingStore "export data" and the
@@ -448,6 +685,7 @@ type SwingStoreExportEventHandler interface {
 type SwingStoreExportsHandler struct {
 	logger       log.Logger
 	blockingSend func(action vm.Jsonable, mustNotBeInited bool) (string, error)
+	queue        *operationQueue
 }
 
 // NewSwingStoreExportsHandler creates a SwingStoreExportsHandler
@@ -455,28 +693,37 @@ func NewSwingStoreExportsHandler(logger log.Logger, blockingSend func(action vm.
 	return &SwingStoreExportsHandler{
 		logger:       logger.With("module", fmt.Sprintf("x/%s", types.ModuleName), "submodule", "SwingStoreExportsHandler"),
 		blockingSend: blockingSend,
+		queue:        &operationQueue{},
 	}
 }
 
-// InitiateExport synchronously verifies that there is not already an export or
-// import operation in progress and initiates a new export in a goroutine,
+// InitiateExport queues a new export operation like QueueExport, for callers
+// which do not need a handle on the operation.
+//
+// Must be called by the main goroutine
+func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) error {
+	_, err := exportsHandler.QueueExport(blockHeight, eventHandler, exportOptions)
+	return err
+}
+
+// QueueExport queues a new export operation performed in a goroutine. Once
+// any previously queued operation is done, the goroutine initiates the export
 // via a dedicated SWING_STORE_EXPORT blockingSend action independent of other
 // block related blockingSends, calling the given eventHandler when a related
 // blockingSend completes. If the eventHandler doesn't retrieve the export,
 // then it sends another blockingSend action to discard it.
 //
 // eventHandler is invoked solely from the spawned goroutine.
-// The "started" and "done" events can be used for synchronization with an
-// active operation taking place in the goroutine, by calling respectively the
+// The "started" and "done" events can be used for synchronization with the
+// operations taking place in the goroutines, by calling respectively the
 // WaitUntilSwingStoreExportStarted and WaitUntilSwingStoreExportDone methods
-// from the goroutine that initiated the export.
+// from the goroutine that initiated the export, or the methods of the returned
+// SwingStoreExportOperation for this operation only.
 //
 // Must be called by the main goroutine
-func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) error {
-	err := checkNotActive()
-	if err != nil {
-		return err
-	}
+func (exportsHandler SwingStoreExportsHandler) QueueExport(blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) (*SwingStoreExportOperation, error) {
+	queue := exportsHandler.queue
+	queue.prune()
 
 	var logger log.Logger
 	if blockHeight != 0 {
@@ -485,40 +732,59 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		logger = exportsHandler.logger.With("height", "latest")
 	}
 
-	// Indicate that an export operation has been initiated by setting the global
-	// activeOperation var.
+	// Queue the export operation.
 	// This structure is used to synchronize with the goroutine spawned below.
 	operationDetails := &operationDetails{
-		blockHeight:         blockHeight,
-		logger:              logger,
-		exportStartedResult: make(chan error, 1),
-		exportRetrieved:     false,
-		exportDone:          make(chan error, 1),
+		blockHeight:     blockHeight,
+		logger:          logger,
+		previous:        queue.last(),
+		exportStarted:   make(chan struct{}),
+		exportRetrieved: false,
+		exportDone:      make(chan struct{}),
+		cancelled:       make(chan struct{}),
+	}
+	queue.operations = append(queue.operations, operationDetails)
+	operation := &SwingStoreExportOperation{queue: queue, details: operationDetails}
+
+	if position := operation.QueuePosition(); position > 0 {
+		logger.Info("queued swing-store export", "position", position)
 	}
-	activeOperation = operationDetails
 
 	go func() {
+		if !operationDetails.waitForPrevious() || !operationDetails.start() {
+			// Cancelled before starting. Unblock any wait for the start right away,
+			// but only complete once the previous operation is done, to preserve
+			// the ordering of operations.
+			operationDetails.startErr = errSwingStoreOperationCancelled
+			close(operationDetails.exportStarted)
+			if operationDetails.previous != nil {
+				<-operationDetails.previous.exportDone
+			}
+			operationDetails.doneErr = errSwingStoreOperationCancelled
+			close(operationDetails.exportDone)
+			logger.Info("cancelled swing-store export")
+			return
+		}
+
 		var err error
 		var startedErr error
 		defer func() {
 			if err == nil {
 				err = startedErr
 			}
-			if err != nil {
-				operationDetails.exportDone <- err
-			}
+			operationDetails.doneErr = err
 			// First, indicate an export is no longer in progress. This ensures that
 			// for an operation with a start error, a call to WaitUntilSwingStoreExportStarted
-			// waiting on exportStartedResult will always find the operation has
-			// completed, and clear the active operation instead of racing if the
+			// waiting on exportStarted will always find the operation has
+			// completed, and prune it from the queue instead of racing if the
 			// channel close order was reversed.
 			close(operationDetails.exportDone)
 			// Then signal the current export operation that it failed to start,
 			// which will be reported to a waiting WaitUntilSwingStoreExportStarted,
 			// or the next call otherwise.
 			if startedErr != nil {
-				operationDetails.exportStartedResult <- startedErr
-				close(operationDetails.exportStartedResult)
+				operationDetails.startErr = startedErr
+				close(operationDetails.exportStarted)
 			}
 		}()
 
@@ -540,19 +806,25 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		}
 
 		// Signal that the export operation has started successfully in the goroutine.
-		// Calls to WaitUntilSwingStoreExportStarted will no longer block.
-		close(operationDetails.exportStartedResult)
+		// Calls to WaitUntilSwingStoreExportStarted will no longer block on it.
+		close(operationDetails.exportStarted)
 
 		// The user provided OnExportStarted function should call retrieveExport()
 		var retrieveErr error
 		err = eventHandler.OnExportStarted(blockHeight, func() error {
-			activeOperationDetails := activeOperation
-			if activeOperationDetails != operationDetails || operationDetails.exportRetrieved {
+			if operationDetails.exportRetrieved {
 				// shouldn't happen, but return an error if it does
 				return errors.New("export operation no longer active")
 			}
 
-			retrieveErr = exportsHandler.retrieveExport(eventHandler.OnExportRetrieved)
+			onExportRetrieved := eventHandler.OnExportRetrieved
+			if exportOptions.Base != nil {
+				onExportRetrieved = func(provider SwingStoreExportProvider) error {
+					return eventHandler.OnExportRetrieved(NewDifferentialSwingStoreExportProvider(provider, exportOptions.Base))
+				}
+			}
+
+			retrieveErr = exportsHandler.retrieveExport(operationDetails, onExportRetrieved)
 
 			return retrieveErr
 		})
@@ -592,7 +864,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		}
 	}()
 
-	return nil
+	return operation, nil
 }
 
 // retrieveExport retrieves an initiated export then invokes onExportRetrieved
@@ -610,13 +882,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 //
 // This will block until the export is ready. Internally invoked by the
 // InitiateExport logic in the export operation's goroutine.
-func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
-	operationDetails := activeOperation
-	if operationDetails == nil {
-		// shouldn't happen, but return an error if it does
-		return errors.New("no active swing-store export operation")
-	}
-
+func (exportsHandler SwingStoreExportsHandler) retrieveExport(operationDetails *operationDetails, onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
 	blockHeight := operationDetails.blockHeight
 
 	action := &swingStoreRetrieveExportAction{
@@ -638,7 +904,7 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 
 	defer os.RemoveAll(exportDir)
 
-	provider, err := OpenSwingStoreExportDirectory(exportDir)
+	provider, err := OpenSwingStoreExportDirectory(exportDir, 0)
 	if err != nil {
 		return err
 	}
@@ -664,14 +930,12 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 // dedicated file, and the export data is read from a jsonl-like file, if any.
 // The export manifest filename and overall export format is common with the JS
 // swing-store import/export logic.
-func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider, error) {
-	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
-	if err != nil {
-		return SwingStoreExportProvider{}, err
-	}
-
-	var manifest exportManifest
-	err = json.Unmarshal(rawManifest, &manifest)
+//
+// A directory using the content-addressed layout may hold several exports. A
+// non-zero blockHeight selects the export at that height, while zero selects
+// the export described by the export manifest, which is the latest written.
+func OpenSwingStoreExportDirectory(exportDir string, blockHeight uint64) (SwingStoreExportProvider, error) {
+	manifest, err := readExportManifest(exportDir, blockHeight)
 	if err != nil {
 		return SwingStoreExportProvider{}, err
 	}
@@ -715,36 +979,81 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
 }
 
+// readExportManifest reads the export manifest of the swing-store export saved
+// on disk in the provided directory, selected by blockHeight as described for
+// OpenSwingStoreExportDirectory.
+func readExportManifest(exportDir string, blockHeight uint64) (exportManifest, error) {
+	var manifest exportManifest
+	manifestPath := ExportManifestFilename
+	if blockHeight != 0 {
+		manifestPath = contentAddressedManifestPath(blockHeight)
+	}
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, filepath.FromSlash(manifestPath)))
+	if err != nil {
+		return manifest, err
+	}
+	err = json.Unmarshal(rawManifest, &manifest)
+	if err == nil && blockHeight != 0 && manifest.BlockHeight != blockHeight {
+		err = fmt.Errorf("export manifest %s has blockHeight %d", manifestPath, manifest.BlockHeight)
+	}
+	return manifest, err
+}
+
+// ListSwingStoreExportDirectoryArtifacts returns the path of the file of each
+// artifact listed by the export manifest in the provided directory, indexed by
+// artifact name. The artifact files are not read.
+func ListSwingStoreExportDirectoryArtifacts(exportDir string) (map[string]string, error) {
+	manifest, err := readExportManifest(exportDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	artifactFiles := make(map[string]string, len(manifest.Artifacts))
+	for _, artifactEntry := range manifest.Artifacts {
+		artifactFiles[artifactEntry[0]] = filepath.Join(exportDir, artifactEntry[1])
+	}
+	return artifactFiles, nil
+}
+
 // RestoreExport restores the JS swing-store using previously exported data and artifacts.
+// If export operations are queued, blocks until they are done before
+// performing the restore.
 //
 // Must be called by the main goroutine
-func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStoreExportProvider, restoreOptions SwingStoreRestoreOptions) error {
-	err := checkNotActive()
-	if err != nil {
-		return err
-	}
+func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStoreExportProvider, restoreOptions SwingStoreRestoreOptions) (err error) {
+	queue := exportsHandler.queue
+	queue.prune()
 
 	blockHeight := provider.BlockHeight
 
-	// We technically don't need to create an active operation here since both
-	// InitiateExport and RestoreExport should only be called from the main
-	// goroutine, but it doesn't cost much to add in case things go wrong.
+	// Since RestoreExport is called from the main goroutine, no operation can
+	// be queued until it returns. The restore operation is still added to the
+	// queue so that it is accounted for while in progress.
 	operationDetails := &operationDetails{
 		isRestore:   true,
 		blockHeight: blockHeight,
 		logger:      exportsHandler.logger,
+		previous:    queue.last(),
 		// goroutine synchronization is unnecessary since anything checking should
 		// be called from the same goroutine.
-		// Effectively WaitUntilSwingStoreExportStarted would block infinitely and
-		// exportsHandler.InitiateExport will error when calling checkNotActive.
-		exportStartedResult: nil,
-		exportDone:          nil,
+		exportStarted: nil,
+		exportDone:    make(chan struct{}),
+		cancelled:     make(chan struct{}),
+		state:         operationStarted,
+		// errors are reported by RestoreExport itself
+		errReported: true,
 	}
-	activeOperation = operationDetails
+	queue.operations = append(queue.operations, operationDetails)
 	defer func() {
-		activeOperation = nil
+		operationDetails.doneErr = err
+		close(operationDetails.exportDone)
+		queue.prune()
 	}()
 
+	if operationDetails.previous != nil {
+		exportsHandler.logger.Info("waiting for queued swing-store exports before restore", "height", blockHeight)
+		<-operationDetails.previous.exportDone
+	}
+
 	exportDir, err := os.MkdirTemp("", fmt.Sprintf("agd-swing-store-restore-%d-*", blockHeight))
 	if err != nil {
 		return err
@@ -784,6 +1093,25 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 // The export manifest filename and overall export format is common with the JS
 // swing-store import/export logic.
 func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string) error {
+	return WriteSwingStoreExportToDirectoryWithLayout(provider, exportDir, SwingStoreExportLayoutFlat)
+}
+
+// WriteSwingStoreExportToDirectoryWithLayout is like
+// WriteSwingStoreExportToDirectory, but names the artifact files according to
+// the provided layout (one of the SwingStoreExportLayout* const values).
+//
+// With the content-addressed layout, an artifact file which already exists in
+// the directory is assumed to have the expected content and is not written
+// again. This allows a new export to be written over a previous one, only
+// adding the artifacts that changed since. The manifest and export data of
+// each export are also kept under a name derived from its block height, so
+// that previous exports remain available to OpenSwingStoreExportDirectory,
+// while the export manifest describes the latest export.
+func WriteSwingStoreExportToDirectoryWithLayout(provider SwingStoreExportProvider, exportDir string, layout string) error {
+	if !isValidSwingStoreExportLayout(layout) {
+		return fmt.Errorf("invalid export layout %q", layout)
+	}
+
 	manifest := exportManifest{
 		BlockHeight: provider.BlockHeight,
 	}
@@ -793,11 +1121,21 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		return err
 	}
 
+	if layout == SwingStoreExportLayoutContentAddressed {
+		err = os.MkdirAll(filepath.Join(exportDir, contentAddressedManifestsDir), os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+
 	if exportDataReader != nil {
 		defer exportDataReader.Close()
 
 		manifest.Data = exportDataFilename
-		exportDataFile, err := os.OpenFile(filepath.Join(exportDir, exportDataFilename), os.O_CREATE|os.O_WRONLY, exportedFilesMode)
+		if layout == SwingStoreExportLayoutContentAddressed {
+			manifest.Data = path.Join(contentAddressedManifestsDir, fmt.Sprintf("%d-%s", provider.BlockHeight, exportDataFilename))
+		}
+		exportDataFile, err := os.OpenFile(filepath.Join(exportDir, filepath.FromSlash(manifest.Data)), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportedFilesMode)
 		if err != nil {
 			return err
 		}
@@ -818,6 +1156,13 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		return os.WriteFile(filepath.Join(exportDir, filename), data, exportedFilesMode)
 	}
 
+	if layout == SwingStoreExportLayoutContentAddressed {
+		err = os.MkdirAll(filepath.Join(exportDir, contentAddressedObjectsDir), os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+
 	for {
 		artifact, err := provider.ReadNextArtifact()
 		if err == io.EOF {
@@ -827,18 +1172,13 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		}
 
 		if artifact.Name != UntrustedExportDataArtifactName {
-			// An artifact is only verifiable by the JS swing-store import using the
-			// information contained in the "export data".
-			// Since we cannot trust the source of the artifact at this point,
-			// including that the artifact's name is genuine, we generate a safe and
-			// unique filename from the artifact's name we received, by substituting
-			// any non letters-digits-hyphen-underscore-dot by a hyphen, and
-			// prefixing with an incremented id.
-			// The filename is not used for any purpose in the import logic.
-			filename := sanitizeArtifactName(artifact.Name)
-			filename = fmt.Sprintf("%d-%s", len(manifest.Artifacts), filename)
+			filename := swingStoreArtifactFilename(layout, len(manifest.Artifacts), artifact)
 			manifest.Artifacts = append(manifest.Artifacts, [2]string{artifact.Name, filename})
-			err = writeExportFile(filename, artifact.Data)
+			if layout == SwingStoreExportLayoutContentAddressed {
+				err = writeContentAddressedFile(filepath.Join(exportDir, filename), artifact.Data)
+			} else {
+				err = writeExportFile(filename, artifact.Data)
+			}
 		} else {
 			// Pseudo artifact containing untrusted export data which may have been
 			// saved separately for debugging purposes (not referenced from the manifest)
@@ -853,5 +1193,66 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 	if err != nil {
 		return err
 	}
+	if layout == SwingStoreExportLayoutContentAddressed {
+		err = writeExportFile(filepath.FromSlash(contentAddressedManifestPath(provider.BlockHeight)), manifestBytes)
+		if err != nil {
+			return err
+		}
+	}
 	return writeExportFile(ExportManifestFilename, manifestBytes)
 }
+
+// swingStoreArtifactFilename returns the filename of the index-th artifact of
+// an export for the given layout.
+func swingStoreArtifactFilename(layout string, index int, artifact types.SwingStoreArtifact) string {
+	if layout == SwingStoreExportLayoutContentAddressed {
+		return ContentAddressedArtifactPath(artifact.Data)
+	}
+
+	// An artifact is only verifiable by the JS swing-store import using the
+	// information contained in the "export data".
+	// Since we cannot trust the source of the artifact at this point,
+	// including that the artifact's name is genuine, we generate a safe and
+	// unique filename from the artifact's name we received, by substituting
+	// any non letters-digits-hyphen-underscore-dot by a hyphen, and
+	// prefixing with an incremented id.
+	// The filename is not used for any purpose in the import logic.
+	return fmt.Sprintf("%d-%s", index, sanitizeArtifactName(artifact.Name))
+}
+
+// ContentAddressedArtifactPath returns the slash separated path, relative to
+// an export directory using the content-addressed layout, of the file holding
+// an artifact with the given data.
+func ContentAddressedArtifactPath(data []byte) string {
+	return path.Join(contentAddressedObjectsDir, sha256Hex(data))
+}
+
+// writeContentAddressedFile writes data to filePath unless the file already
+// exists. The data is first written to a temporary file which is then renamed,
+// so that an interrupted write does not leave behind a file with the expected
+// name but incomplete content.
+func writeContentAddressedFile(filePath string, data []byte) error {
+	if _, err := os.Stat(filePath); err == nil {
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	if _, err = tmpFile.Write(data); err != nil {
+		return err
+	}
+	if err = tmpFile.Chmod(exportedFilesMode); err != nil {
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filePath)
+}
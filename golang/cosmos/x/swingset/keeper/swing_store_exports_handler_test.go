@@ -3,7 +3,9 @@ package keeper
 import (
 	"errors"
 	"io"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/tendermint/tendermint/libs/log"
@@ -14,6 +16,7 @@ func newTestSwingStoreExportsHandler() *SwingStoreExportsHandler {
 	return &SwingStoreExportsHandler{
 		logger:       logger,
 		blockingSend: func(action vm.Jsonable, mustNotBeInited bool) (string, error) { return "", nil },
+		queue:        &operationQueue{},
 	}
 }
 
@@ -50,46 +53,107 @@ func (taker testSwingStoreEventHandler) OnExportRetrieved(provider SwingStoreExp
 	return taker.onExportRetrieved(provider)
 }
 
-func TestSwingStoreSnapshotterInProgress(t *testing.T) {
+func TestSwingStoreSnapshotterQueued(t *testing.T) {
 	exportsHandler := newTestSwingStoreExportsHandler()
 	ch := make(chan struct{})
+	startedHeights := []uint64{}
 	exportEventHandler := newTestSwingStoreEventHandler()
 	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
-		<-ch
+		startedHeights = append(startedHeights, height)
+		if height == 123 {
+			<-ch
+		}
 		return nil
 	}
-	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+
+	first, err := exportsHandler.QueueExport(123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = first.WaitUntilStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = exportsHandler.InitiateExport(456, newTestSwingStoreEventHandler(), SwingStoreExportOptions{})
-	if err == nil {
-		t.Error("wanted error for export operation in progress")
+	second, err := exportsHandler.QueueExport(456, exportEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	third, err := exportsHandler.QueueExport(789, exportEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fourth, err := exportsHandler.QueueExport(1011, exportEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	err = exportsHandler.RestoreExport(SwingStoreExportProvider{BlockHeight: 456}, SwingStoreRestoreOptions{})
-	if err == nil {
-		t.Error("wanted error for export operation in progress")
+	for i, operation := range []*SwingStoreExportOperation{first, second, third, fourth} {
+		if position := operation.QueuePosition(); position != i {
+			t.Errorf("operation %d: got queue position %d, wanted %d", i, position, i)
+		}
+	}
+
+	err = third.Cancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if position := third.QueuePosition(); position != -1 {
+		t.Errorf("cancelled operation: got queue position %d, wanted -1", position)
+	}
+	if position := fourth.QueuePosition(); position != 2 {
+		t.Errorf("operation after cancelled one: got queue position %d, wanted 2", position)
+	}
+	if err := first.Cancel(); err == nil {
+		t.Error("wanted error cancelling a started operation")
 	}
 
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = exportsHandler.InitiateExport(456, exportEventHandler, SwingStoreExportOptions{})
+	if err := third.WaitUntilDone(); err != errSwingStoreOperationCancelled {
+		t.Errorf("wanted cancellation error, got %v", err)
+	}
+	if position := fourth.QueuePosition(); position != -1 {
+		t.Errorf("done operation: got queue position %d, wanted -1", position)
+	}
+	expectedHeights := []uint64{123, 456, 1011}
+	if !reflect.DeepEqual(startedHeights, expectedHeights) {
+		t.Errorf("got started heights %v, wanted %v", startedHeights, expectedHeights)
+	}
+}
+
+func TestSwingStoreSnapshotterRestoreAfterQueued(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	exportDone := false
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		restoreAction, ok := action.(*swingStoreRestoreExportAction)
+		if ok && restoreAction.Request == "restore" && !exportDone {
+			return "", errors.New("restore before export done")
+		}
+		return "", nil
+	}
+	exportEventHandler := newTestSwingStoreEventHandler()
+	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
+		time.Sleep(10 * time.Millisecond)
+		exportDone = true
+		return nil
+	}
+
+	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+
+	err = exportsHandler.RestoreExport(newTestExportProvider(nil, nil), SwingStoreRestoreOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
+	if len(exportsHandler.queue.operations) != 0 {
+		t.Errorf("wanted empty queue, got %d operations", len(exportsHandler.queue.operations))
+	}
 }
 
 func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
@@ -104,7 +168,7 @@ func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// First run through app.Commit()
-	err := WaitUntilSwingStoreExportStarted()
+	err := exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -114,14 +178,14 @@ func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// Second run through app.Commit() - should return right away
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// close the signaling channel to let goroutine exit
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -142,7 +206,7 @@ func TestSwingStoreSnapshotterInitiateFails(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err == nil {
 		t.Fatal("wanted initiation error")
 	}
@@ -150,11 +214,11 @@ func TestSwingStoreSnapshotterInitiateFails(t *testing.T) {
 		t.Errorf(`wanted error "initiate failed", got "%s"`, err.Error())
 	}
 	// another wait should succeed without error
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Error(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -184,7 +248,7 @@ func TestSwingStoreSnapshotterRetrievalFails(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -193,7 +257,7 @@ func TestSwingStoreSnapshotterRetrievalFails(t *testing.T) {
 	if savedErr != retrieveError {
 		t.Errorf(`wanted retrieval error, got "%v"`, savedErr)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != retrieveError {
 		t.Errorf(`wanted retrieval error, got "%v"`, err)
 	}
@@ -213,14 +277,14 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 	// simulate an onExportStarted which successfully calls retrieveExport()
 	exportEventHandler := newTestSwingStoreEventHandler()
 	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
-		activeOperation.exportRetrieved = true
+		exportsHandler.queue.operations[0].exportRetrieved = true
 		return nil
 	}
 	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -237,7 +301,7 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -245,3 +309,55 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 		t.Error("wanted discard called")
 	}
 }
+
+func TestValidateSwingStoreExportOptions(t *testing.T) {
+	for _, tt := range []struct {
+		artifactMode   string
+		exportDataMode string
+		valid          bool
+	}{
+		{SwingStoreArtifactModeOperational, SwingStoreExportDataModeAll, true},
+		{SwingStoreArtifactModeDebug, SwingStoreExportDataModeSkip, true},
+		{SwingStoreArtifactModeNone, SwingStoreExportDataModeAll, true},
+		{SwingStoreArtifactModeNone, SwingStoreExportDataModeSkip, false},
+		{SwingStoreArtifactModeReplay, SwingStoreExportDataModeRepairMetadata, false},
+		{"everything", SwingStoreExportDataModeAll, false},
+		{SwingStoreArtifactModeReplay, "", false},
+	} {
+		err := ValidateSwingStoreExportOptions(SwingStoreExportOptions{
+			ArtifactMode:   tt.artifactMode,
+			ExportDataMode: tt.exportDataMode,
+		})
+		if tt.valid && err != nil {
+			t.Errorf("%s/%s: unexpected error %v", tt.artifactMode, tt.exportDataMode, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("%s/%s: expected an error", tt.artifactMode, tt.exportDataMode)
+		}
+	}
+}
+
+func TestValidateSwingStoreRestoreOptions(t *testing.T) {
+	for _, tt := range []struct {
+		artifactMode   string
+		exportDataMode string
+		valid          bool
+	}{
+		{SwingStoreArtifactModeOperational, SwingStoreExportDataModeAll, true},
+		{SwingStoreArtifactModeArchival, SwingStoreExportDataModeAll, true},
+		{SwingStoreArtifactModeNone, SwingStoreExportDataModeRepairMetadata, true},
+		{SwingStoreArtifactModeNone, SwingStoreExportDataModeAll, false},
+		{SwingStoreArtifactModeReplay, SwingStoreExportDataModeRepairMetadata, false},
+		{SwingStoreArtifactModeReplay, SwingStoreExportDataModeSkip, false},
+		{"", SwingStoreExportDataModeAll, false},
+	} {
+		err := ValidateSwingStoreRestoreOptions(SwingStoreRestoreOptions{
+			ArtifactMode:   tt.artifactMode,
+			ExportDataMode: tt.exportDataMode,
+		})
+		if tt.valid && err != nil {
+			t.Errorf("%s/%s: unexpected error %v", tt.artifactMode, tt.exportDataMode, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("%s/%s: expected an error", tt.artifactMode, tt.exportDataMode)
+		}
+	}
+}
@@ -85,10 +85,12 @@ type AppModule struct {
 	setBootstrapNeeded       func()
 	ensureControllerInited   func(sdk.Context)
 	swingStoreExportDir      string
+	swingStoreArtifactsMode  string
+	swingStoreInlineMaxSize  uint64
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string) AppModule {
+func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string, swingStoreArtifactsMode string, swingStoreInlineMaxSize uint64) AppModule {
 	am := AppModule{
 		AppModuleBasic:           AppModuleBasic{},
 		keeper:                   k,
@@ -96,6 +98,8 @@ func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler,
 		setBootstrapNeeded:       setBootstrapNeeded,
 		ensureControllerInited:   ensureControllerInited,
 		swingStoreExportDir:      swingStoreExportDir,
+		swingStoreArtifactsMode:  swingStoreArtifactsMode,
+		swingStoreInlineMaxSize:  swingStoreInlineMaxSize,
 	}
 	return am
 }
@@ -128,9 +132,17 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	am.ensureControllerInited(ctx)
@@ -173,6 +185,6 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	am.checkSwingStoreExportSetup()
-	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir)
+	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, am.swingStoreArtifactsMode, am.swingStoreInlineMaxSize)
 	return cdc.MustMarshalJSON(gs)
 }
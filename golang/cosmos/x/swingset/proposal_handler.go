@@ -16,6 +16,9 @@ func NewSwingSetProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 		case *types.CoreEvalProposal:
 			return k.CoreEvalProposal(ctx, c)
 
+		case *types.HighPrioritySendersProposal:
+			return k.HighPrioritySendersProposal(ctx, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized swingset proposal content type: %T", c)
 		}
@@ -52,22 +52,22 @@ func (ph portHandler) Receive(cctx context.Context, str string) (string, error)
 }
 
 func (ph portHandler) handleSwingStoreUpdateExportData(ctx sdk.Context, entries []json.RawMessage) (ret string, err error) {
-	store := ph.keeper.GetSwingStore(ctx)
 	exportDataReader := agoric.NewJsonRawMessageKVEntriesReader(entries)
 	defer exportDataReader.Close()
+	exportDataEntries := make([]agoric.KVEntry, 0, len(entries))
 	for {
 		entry, err := exportDataReader.Read()
 		if err == io.EOF {
-			return "true", nil
+			break
 		} else if err != nil {
 			return ret, err
 		}
+		exportDataEntries = append(exportDataEntries, entry)
+	}
 
-		key := []byte(entry.Key())
-		if !entry.HasValue() {
-			store.Delete(key)
-		} else {
-			store.Set(key, []byte(entry.StringValue()))
-		}
+	err = ph.keeper.UpdateSwingStoreExportData(ctx, exportDataEntries)
+	if err != nil {
+		return ret, err
 	}
+	return "true", nil
 }
@@ -33,6 +33,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgProvision{}, ModuleName+"/Provision", nil)
 	cdc.RegisterConcrete(&MsgWalletAction{}, ModuleName+"/WalletAction", nil)
 	cdc.RegisterConcrete(&MsgWalletSpendAction{}, ModuleName+"/WalletSpendAction", nil)
+	cdc.RegisterConcrete(&MsgWalletActionBatch{}, ModuleName+"/WalletActionBatch", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
@@ -42,10 +43,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgProvision{},
 		&MsgWalletAction{},
 		&MsgWalletSpendAction{},
+		&MsgWalletActionBatch{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CoreEvalProposal{},
+		&HighPrioritySendersProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
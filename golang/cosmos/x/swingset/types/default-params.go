@@ -66,6 +66,16 @@ var (
 	DefaultQueueMax = []QueueSize{
 		NewQueueSize(QueueInbound, DefaultInboundQueueMax),
 	}
+
+	DefaultMaxInboundPerTx = int32(1)
+
+	// DefaultInboundMempoolQueueRatio admits messages into the mempool as if
+	// the inbound queue max size were half of its actual value.
+	DefaultInboundMempoolQueueRatio = sdk.NewDecWithPrec(5, 1) // 0.5
+
+	// DefaultExportDataBlockBudget does not limit the swing-store export data
+	// written per block.
+	DefaultExportDataBlockBudget = uint64(0)
 )
 
 // move DefaultBeansPerUnit to a function to allow for boot overriding of the Default params
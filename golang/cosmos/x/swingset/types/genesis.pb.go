@@ -28,6 +28,11 @@ type GenesisState struct {
 	Params               Params                       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	State                State                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
 	SwingStoreExportData []*SwingStoreExportDataEntry `protobuf:"bytes,4,rep,name=swing_store_export_data,json=swingStoreExportData,proto3" json:"swingStoreExportData"`
+	// How the swing-store artifacts are provided: empty or "directory" when
+	// they are described by the manifest of the swing-store export directory,
+	// "reference" or "inline" when they are listed in swing_store_artifacts.
+	SwingStoreArtifactsMode string                       `protobuf:"bytes,5,opt,name=swing_store_artifacts_mode,json=swingStoreArtifactsMode,proto3" json:"swingStoreArtifactsMode"`
+	SwingStoreArtifacts     []*SwingStoreGenesisArtifact `protobuf:"bytes,6,rep,name=swing_store_artifacts,json=swingStoreArtifacts,proto3" json:"swingStoreArtifacts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +89,94 @@ func (m *GenesisState) GetSwingStoreExportData() []*SwingStoreExportDataEntry {
 	return nil
 }
 
+func (m *GenesisState) GetSwingStoreArtifactsMode() string {
+	if m != nil {
+		return m.SwingStoreArtifactsMode
+	}
+	return ""
+}
+
+func (m *GenesisState) GetSwingStoreArtifacts() []*SwingStoreGenesisArtifact {
+	if m != nil {
+		return m.SwingStoreArtifacts
+	}
+	return nil
+}
+
+// A swing-store artifact listed in the genesis.
+type SwingStoreGenesisArtifact struct {
+	// The name of the artifact.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The hex encoded sha256 of the artifact data.
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// The slash separated path of the file holding the artifact data, relative
+	// to the swing-store export directory ("reference" mode only).
+	URI string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// The artifact data ("inline" mode only).
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SwingStoreGenesisArtifact) Reset()         { *m = SwingStoreGenesisArtifact{} }
+func (m *SwingStoreGenesisArtifact) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreGenesisArtifact) ProtoMessage()    {}
+func (*SwingStoreGenesisArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49b057311de9d296, []int{1}
+}
+func (m *SwingStoreGenesisArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreGenesisArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreGenesisArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreGenesisArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreGenesisArtifact.Merge(m, src)
+}
+func (m *SwingStoreGenesisArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreGenesisArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreGenesisArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreGenesisArtifact proto.InternalMessageInfo
+
+func (m *SwingStoreGenesisArtifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SwingStoreGenesisArtifact) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *SwingStoreGenesisArtifact) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *SwingStoreGenesisArtifact) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // A SwingStore "export data" entry.
 type SwingStoreExportDataEntry struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -94,7 +187,7 @@ func (m *SwingStoreExportDataEntry) Reset()         { *m = SwingStoreExportDataE
 func (m *SwingStoreExportDataEntry) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreExportDataEntry) ProtoMessage()    {}
 func (*SwingStoreExportDataEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49b057311de9d296, []int{1}
+	return fileDescriptor_49b057311de9d296, []int{2}
 }
 func (m *SwingStoreExportDataEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,34 +232,44 @@ func (m *SwingStoreExportDataEntry) GetValue() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.swingset.GenesisState")
+	proto.RegisterType((*SwingStoreGenesisArtifact)(nil), "agoric.swingset.SwingStoreGenesisArtifact")
 	proto.RegisterType((*SwingStoreExportDataEntry)(nil), "agoric.swingset.SwingStoreExportDataEntry")
 }
 
 func init() { proto.RegisterFile("agoric/swingset/genesis.proto", fileDescriptor_49b057311de9d296) }
 
 var fileDescriptor_49b057311de9d296 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4b, 0x02, 0x41,
-	0x1c, 0xc5, 0x77, 0xf2, 0x07, 0x38, 0x06, 0xc5, 0x22, 0xb9, 0x09, 0x8d, 0xe2, 0x49, 0x82, 0x76,
-	0xc0, 0xe8, 0x52, 0xa7, 0x2c, 0xe9, 0x1a, 0x2b, 0x5d, 0xba, 0xc8, 0xa8, 0xc3, 0xb4, 0xa8, 0x3b,
-	0xcb, 0x7c, 0xc7, 0x52, 0xfa, 0x27, 0xfa, 0x13, 0xfa, 0x73, 0x3c, 0x7a, 0xec, 0x24, 0xa1, 0x97,
-	0xe8, 0x6f, 0xe8, 0x10, 0x3b, 0xa3, 0x04, 0x6a, 0xb7, 0xb7, 0xfb, 0x79, 0xef, 0x0d, 0x33, 0x0f,
-	0x9f, 0x30, 0x21, 0x55, 0xd8, 0xa5, 0xf0, 0x12, 0x46, 0x02, 0xb8, 0xa6, 0x82, 0x47, 0x1c, 0x42,
-	0xf0, 0x63, 0x25, 0xb5, 0x74, 0x0f, 0x2c, 0xf6, 0xd7, 0xb8, 0x54, 0x10, 0x52, 0x48, 0xc3, 0x68,
-	0xa2, 0xac, 0xad, 0x44, 0x36, 0x5b, 0xd6, 0xc2, 0xf2, 0xea, 0x0f, 0xc2, 0xfb, 0x77, 0xb6, 0xb8,
-	0xa5, 0x99, 0xe6, 0xee, 0x05, 0xce, 0xc6, 0x4c, 0xb1, 0x21, 0x78, 0x7b, 0x15, 0x54, 0xcb, 0xd7,
-	0x8b, 0xfe, 0xc6, 0x41, 0xfe, 0xbd, 0xc1, 0x8d, 0xf4, 0x74, 0x5e, 0x76, 0x82, 0x95, 0xd9, 0xad,
-	0xe3, 0x0c, 0x24, 0x79, 0x2f, 0x65, 0x52, 0x47, 0x5b, 0x29, 0xd3, 0xbe, 0x0a, 0x59, 0xab, 0xfb,
-	0x8a, 0x8b, 0x06, 0xb7, 0x41, 0x4b, 0xc5, 0xdb, 0x7c, 0x1c, 0x4b, 0xa5, 0xdb, 0x3d, 0xa6, 0x99,
-	0x97, 0xae, 0xa4, 0x6a, 0xf9, 0xfa, 0xe9, 0x76, 0x4b, 0x22, 0x5a, 0x89, 0xbd, 0x69, 0xdc, 0xb7,
-	0x4c, 0xb3, 0x66, 0xa4, 0xd5, 0xa4, 0xe1, 0x7d, 0xcf, 0xcb, 0x05, 0xd8, 0x81, 0x83, 0x9d, 0x7f,
-	0x2f, 0xd3, 0x5f, 0xef, 0x65, 0xa7, 0x7a, 0x83, 0x8f, 0xff, 0xad, 0x74, 0x0f, 0x71, 0xaa, 0xcf,
-	0x27, 0x1e, 0xaa, 0xa0, 0x5a, 0x2e, 0x48, 0xa4, 0x5b, 0xc0, 0x99, 0x67, 0x36, 0x18, 0x71, 0xf3,
-	0x36, 0xb9, 0xc0, 0x7e, 0x34, 0x1e, 0xa6, 0x0b, 0x82, 0x66, 0x0b, 0x82, 0x3e, 0x17, 0x04, 0xbd,
-	0x2d, 0x89, 0x33, 0x5b, 0x12, 0xe7, 0x63, 0x49, 0x9c, 0xc7, 0x2b, 0x11, 0xea, 0xa7, 0x51, 0xc7,
-	0xef, 0xca, 0x21, 0xbd, 0xb6, 0x43, 0xd8, 0x1b, 0x9d, 0x41, 0xaf, 0x4f, 0x85, 0x1c, 0xb0, 0x48,
-	0xd0, 0xae, 0x84, 0xa1, 0x04, 0x3a, 0xfe, 0xdb, 0x48, 0x4f, 0x62, 0x0e, 0x9d, 0xac, 0x59, 0xe8,
-	0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x94, 0xe9, 0x22, 0x36, 0x09, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x8e, 0x49, 0x1b, 0x54, 0x6f, 0x12, 0xc8, 0x14, 0x9a, 0x15, 0x91, 0x54, 0x3d, 0x55, 0x48,
+	0x24, 0x52, 0xd1, 0x38, 0xc0, 0x69, 0x81, 0x09, 0x71, 0x40, 0x42, 0xae, 0x26, 0x21, 0x2e, 0x95,
+	0xd7, 0x1a, 0x2f, 0xda, 0x12, 0x57, 0xb6, 0xcb, 0x56, 0x71, 0xe3, 0x17, 0xf0, 0x13, 0xf8, 0x39,
+	0xbb, 0xb1, 0x23, 0xa7, 0x08, 0xa5, 0x17, 0xd4, 0x5f, 0x81, 0x6c, 0xa7, 0xda, 0xd4, 0xa5, 0xda,
+	0xed, 0xcb, 0xfb, 0xbe, 0xf7, 0xbd, 0xe7, 0x7c, 0x0f, 0x3e, 0x23, 0x8c, 0x8b, 0x74, 0x12, 0xcb,
+	0xf3, 0x34, 0x67, 0x92, 0xaa, 0x98, 0xd1, 0x9c, 0xca, 0x54, 0x46, 0x33, 0xc1, 0x15, 0x47, 0x0f,
+	0x2c, 0x1d, 0xad, 0xe9, 0x6e, 0x9b, 0x71, 0xc6, 0x0d, 0x17, 0x6b, 0x64, 0x65, 0xdd, 0x60, 0xd3,
+	0x65, 0x0d, 0x2c, 0xdf, 0xff, 0xed, 0xc2, 0xdd, 0xf7, 0xd6, 0x78, 0xa4, 0x88, 0xa2, 0x68, 0x1f,
+	0x7a, 0x33, 0x22, 0x48, 0x26, 0xfd, 0x7b, 0x3d, 0x30, 0xd8, 0x19, 0x76, 0xa2, 0x8d, 0x41, 0xd1,
+	0x27, 0x43, 0x27, 0x8d, 0xcb, 0x22, 0x74, 0x70, 0x25, 0x46, 0x43, 0xd8, 0x94, 0xba, 0xdf, 0x77,
+	0x4d, 0xd7, 0x93, 0x5b, 0x5d, 0xc6, 0xbd, 0x6a, 0xb2, 0x52, 0xf4, 0x1d, 0x76, 0x0c, 0x3d, 0x96,
+	0x8a, 0x0b, 0x3a, 0xa6, 0x17, 0x33, 0x2e, 0xd4, 0x78, 0x4a, 0x14, 0xf1, 0x1b, 0x3d, 0x77, 0xb0,
+	0x33, 0x7c, 0x7e, 0xdb, 0x45, 0x83, 0x91, 0x96, 0x1f, 0x1a, 0xf5, 0x3b, 0xa2, 0xc8, 0x61, 0xae,
+	0xc4, 0x22, 0xf1, 0x57, 0x45, 0xd8, 0x96, 0x35, 0x34, 0xae, 0xad, 0xa2, 0xcf, 0xb0, 0x7b, 0x73,
+	0x38, 0x11, 0x2a, 0xfd, 0x4a, 0x26, 0x4a, 0x8e, 0x33, 0x3e, 0xa5, 0x7e, 0xb3, 0x07, 0x06, 0xad,
+	0xe4, 0xe9, 0xaa, 0x08, 0x3b, 0xd7, 0xdd, 0x07, 0x6b, 0xcd, 0x47, 0x3e, 0xa5, 0x78, 0x1b, 0x81,
+	0xce, 0xe1, 0xe3, 0x5a, 0x67, 0xdf, 0xbb, 0xf3, 0x51, 0x55, 0x12, 0x6b, 0xbf, 0xa4, 0xb3, 0x2a,
+	0xc2, 0x47, 0x35, 0x73, 0x70, 0x5d, 0xf1, 0x75, 0xe3, 0xdf, 0xaf, 0xd0, 0xe9, 0xff, 0x00, 0x70,
+	0x6f, 0xab, 0x23, 0x42, 0xb0, 0x91, 0x93, 0x8c, 0xfa, 0x40, 0x3f, 0x10, 0x1b, 0x8c, 0xfa, 0xd0,
+	0x93, 0x27, 0x64, 0xb8, 0xff, 0xca, 0x44, 0xde, 0x4a, 0x60, 0x59, 0x84, 0xde, 0xc8, 0x54, 0x70,
+	0xc5, 0xa0, 0x3d, 0xe8, 0xce, 0x45, 0x6a, 0xd2, 0x6d, 0x25, 0xf7, 0xcb, 0x22, 0x74, 0x8f, 0xf0,
+	0x07, 0xac, 0x6b, 0xda, 0xb2, 0xca, 0x0c, 0x0c, 0x76, 0xb1, 0xc1, 0xfd, 0xb7, 0x37, 0x77, 0xd8,
+	0x88, 0x0a, 0x3d, 0x84, 0xee, 0x29, 0x5d, 0x54, 0x2b, 0x68, 0x88, 0xda, 0xb0, 0xf9, 0x8d, 0x9c,
+	0xcd, 0xa9, 0x5d, 0x00, 0xdb, 0x8f, 0xe4, 0xe8, 0xb2, 0x0c, 0xc0, 0x55, 0x19, 0x80, 0xbf, 0x65,
+	0x00, 0x7e, 0x2e, 0x03, 0xe7, 0x6a, 0x19, 0x38, 0x7f, 0x96, 0x81, 0xf3, 0xe5, 0x0d, 0x4b, 0xd5,
+	0xc9, 0xfc, 0x38, 0x9a, 0xf0, 0x2c, 0x3e, 0xb0, 0x07, 0x6e, 0x7f, 0xea, 0x0b, 0x39, 0x3d, 0x8d,
+	0x19, 0x3f, 0x23, 0x39, 0x8b, 0x27, 0x5c, 0x66, 0x5c, 0xc6, 0x17, 0xd7, 0xb7, 0xaf, 0x16, 0x33,
+	0x2a, 0x8f, 0x3d, 0x73, 0xf9, 0x2f, 0xff, 0x0f, 0x00, 0xda, 0xde, 0xb0, 0xe2, 0x61, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -189,6 +292,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SwingStoreArtifacts) > 0 {
+		for iNdEx := len(m.SwingStoreArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwingStoreArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SwingStoreArtifactsMode) > 0 {
+		i -= len(m.SwingStoreArtifactsMode)
+		copy(dAtA[i:], m.SwingStoreArtifactsMode)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SwingStoreArtifactsMode)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.SwingStoreExportData) > 0 {
 		for iNdEx := len(m.SwingStoreExportData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -226,6 +350,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwingStoreGenesisArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreGenesisArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreGenesisArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SwingStoreExportDataEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -290,6 +465,41 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.SwingStoreArtifactsMode)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SwingStoreArtifacts) > 0 {
+		for _, e := range m.SwingStoreArtifacts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwingStoreGenesisArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -445,6 +655,252 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwingStoreArtifactsMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwingStoreArtifactsMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwingStoreArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwingStoreArtifacts = append(m.SwingStoreArtifacts, &SwingStoreGenesisArtifact{})
+			if err := m.SwingStoreArtifacts[len(m.SwingStoreArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwingStoreGenesisArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwingStoreGenesisArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwingStoreGenesisArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
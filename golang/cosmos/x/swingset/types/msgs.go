@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"fmt"
 	"io"
+	"math"
 	"strings"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/andybalholm/brotli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/klauspost/compress/zstd"
 )
 
 const RouterKey = ModuleName // this was defined in your key.go file
@@ -20,12 +24,14 @@ var (
 	_ sdk.Msg = &MsgInstallBundle{}
 	_ sdk.Msg = &MsgWalletAction{}
 	_ sdk.Msg = &MsgWalletSpendAction{}
+	_ sdk.Msg = &MsgWalletActionBatch{}
 
 	_ vm.ControllerAdmissionMsg = &MsgDeliverInbound{}
 	_ vm.ControllerAdmissionMsg = &MsgInstallBundle{}
 	_ vm.ControllerAdmissionMsg = &MsgProvision{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletAction{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletSpendAction{}
+	_ vm.ControllerAdmissionMsg = &MsgWalletActionBatch{}
 )
 
 const (
@@ -277,6 +283,91 @@ func (msg MsgWalletSpendAction) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWalletActionBatch(owner sdk.AccAddress, actions []string, spend bool) *MsgWalletActionBatch {
+	return &MsgWalletActionBatch{
+		Owner:   owner,
+		Actions: actions,
+		Spend:   spend,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The whole batch is charged at once, including the per-message beans for
+// each of its actions.
+func (msg MsgWalletActionBatch) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	err := checkSmartWalletProvisioned(ctx, keeper, msg.Owner)
+	if err != nil {
+		return err
+	}
+
+	return chargeAdmission(ctx, keeper, msg.Owner, msg.Actions, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgWalletActionBatch) GetInboundMsgCount() int32 {
+	if len(msg.Actions) > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(len(msg.Actions))
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+// Like MsgWalletSpendAction, only a spending batch may be high priority.
+func (msg MsgWalletActionBatch) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	if !msg.Spend {
+		return false, nil
+	}
+
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	return keeper.IsHighPriorityAddress(ctx, msg.Owner)
+}
+
+func (msg MsgWalletActionBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWalletActionBatch) GetSignBytes() []byte {
+	if msg.Actions == nil {
+		msg.Actions = []string{}
+	}
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgWalletActionBatch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWalletActionBatch) Type() string { return "wallet_action_batch" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWalletActionBatch) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	if len(msg.Actions) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Actions cannot be empty")
+	}
+	for i, action := range msg.Actions {
+		if len(strings.TrimSpace(action)) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Action %d cannot be empty", i)
+		}
+		if !json.Valid([]byte(action)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "Wallet action %d must be valid JSON", i)
+		}
+	}
+	return nil
+}
+
 func NewMsgProvision(nickname string, addr sdk.AccAddress, powerFlags []string, submitter sdk.AccAddress) *MsgProvision {
 	return &MsgProvision{
 		Nickname:   nickname,
@@ -389,6 +480,12 @@ func (msg MsgInstallBundle) ValidateBasic() error {
 	if len(msg.CompressedBundle) > 0 && !(msg.UncompressedSize > 0) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size must be positive")
 	}
+	if len(msg.Bundle) > 0 && msg.Compression != CompressionGzip {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Compression cannot be set without a compressed bundle")
+	}
+	if !msg.Compression.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unknown compression algorithm %d", msg.Compression)
+	}
 	if msg.UncompressedSize >= bundleUncompressedSizeLimit {
 		// must enforce a limit to avoid overflow when computing its successor in Uncompress()
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size out of range")
@@ -410,7 +507,73 @@ func (msg MsgInstallBundle) ExpectedUncompressedSize() uint64 {
 	return uint64(len(msg.Bundle))
 }
 
-// Compress ensures that a validated bundle has been gzip-compressed.
+// ParseCompressionAlgorithm returns the CompressionAlgorithm named by a
+// short lowercase name such as "gzip", "zstd" or "brotli".
+func ParseCompressionAlgorithm(name string) (CompressionAlgorithm, error) {
+	for algo := range CompressionAlgorithm_name {
+		if CompressionAlgorithm(algo).ShortName() == name {
+			return CompressionAlgorithm(algo), nil
+		}
+	}
+	return CompressionGzip, fmt.Errorf("unknown compression algorithm %q", name)
+}
+
+// ShortName returns the lowercase name of the algorithm, e.g. "zstd".
+func (algo CompressionAlgorithm) ShortName() string {
+	return strings.ToLower(strings.TrimPrefix(algo.String(), "COMPRESSION_ALGORITHM_"))
+}
+
+// IsValid returns whether the algorithm is one that we know how to handle.
+func (algo CompressionAlgorithm) IsValid() bool {
+	_, ok := CompressionAlgorithm_name[int32(algo)]
+	return ok
+}
+
+// newCompressor returns a writer that compresses to w using algo.
+// The returned writer must be closed to flush its output.
+func newCompressor(algo CompressionAlgorithm, w io.Writer) (io.WriteCloser, error) {
+	switch algo {
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	case CompressionBrotli:
+		return brotli.NewWriterLevel(w, brotli.BestCompression), nil
+	default:
+		return nil, fmt.Errorf("unknown compression algorithm %d", algo)
+	}
+}
+
+// newDecompressor returns a reader that decompresses r using algo, and a
+// function to release its resources.
+func newDecompressor(algo CompressionAlgorithm, r io.Reader) (io.Reader, func(), error) {
+	switch algo {
+	case CompressionGzip:
+		gzipReader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		return gzipReader, func() { gzipReader.Close() }, nil
+	case CompressionZstd:
+		// Avoid spawning background goroutines, and refuse to allocate
+		// windows bigger than the largest bundle we would accept.
+		zstdReader, err := zstd.NewReader(r,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(bundleUncompressedSizeLimit)),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		return zstdReader, zstdReader.Close, nil
+	case CompressionBrotli:
+		return brotli.NewReader(r), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown compression algorithm %d", algo)
+	}
+}
+
+// Compress ensures that a validated bundle has been compressed using the
+// algorithm given by msg.Compression (gzip by default).
 func (msg *MsgInstallBundle) Compress() error {
 	if len(msg.Bundle) == 0 {
 		return nil
@@ -418,19 +581,25 @@ func (msg *MsgInstallBundle) Compress() error {
 	msg.UncompressedSize = int64(len(msg.Bundle))
 	inBuf := strings.NewReader(msg.Bundle)
 	var outBuf bytes.Buffer
-	gzipWriter := gzip.NewWriter(&outBuf)
-	_, err := io.Copy(gzipWriter, inBuf)
+	compressor, err := newCompressor(msg.Compression, &outBuf)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(compressor, inBuf)
+	if err != nil {
+		return err
+	}
+	err = compressor.Close() // required to flush to underlying buffer
 	if err != nil {
 		return err
 	}
-	gzipWriter.Close() // required to flush to underlying buffer
 	msg.CompressedBundle = outBuf.Bytes()
 	msg.Bundle = ""
 	return nil
 }
 
 // Uncompress ensures that a validated bundle is uncompressed,
-// gzip-uncompressing it if necessary.
+// uncompressing it with msg.Compression if necessary.
 // Returns an error (and ends uncompression early) if the uncompressed
 // size does not match the expected uncompressed size.
 // The successor of the uncompressed size must not overflow.
@@ -439,15 +608,16 @@ func (msg *MsgInstallBundle) Uncompress() error {
 		return nil
 	}
 	bytesReader := bytes.NewReader(msg.CompressedBundle)
-	ungzipReader, err := gzip.NewReader(bytesReader)
+	decompressor, release, err := newDecompressor(msg.Compression, bytesReader)
 	if err != nil {
 		return err
 	}
+	defer release()
 	// Read at most one byte over expected size.
 	// Computation doesn't overflow because of ValidateBasic check.
 	// Setting the limit over the expected size is needed to detect
 	// expansion beyond expectations.
-	limitedReader := io.LimitedReader{R: ungzipReader, N: msg.UncompressedSize + 1}
+	limitedReader := io.LimitedReader{R: decompressor, N: msg.UncompressedSize + 1}
 	var buf bytes.Buffer
 	n, err := io.Copy(&buf, &limitedReader)
 	if err != nil {
@@ -459,5 +629,6 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	msg.Bundle = buf.String()
 	msg.CompressedBundle = []byte{}
 	msg.UncompressedSize = 0
+	msg.Compression = CompressionGzip
 	return nil
 }
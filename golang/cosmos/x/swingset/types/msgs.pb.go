@@ -29,6 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CompressionAlgorithm enumerates the supported bundle compression algorithms.
+type CompressionAlgorithm int32
+
+const (
+	// gzip (RFC 1952), the original and default algorithm.
+	CompressionGzip CompressionAlgorithm = 0
+	// Zstandard (RFC 8878).
+	CompressionZstd CompressionAlgorithm = 1
+	// Brotli (RFC 7932).
+	CompressionBrotli CompressionAlgorithm = 2
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "COMPRESSION_ALGORITHM_GZIP",
+	1: "COMPRESSION_ALGORITHM_ZSTD",
+	2: "COMPRESSION_ALGORITHM_BROTLI",
+}
+
+var CompressionAlgorithm_value = map[string]int32{
+	"COMPRESSION_ALGORITHM_GZIP":   0,
+	"COMPRESSION_ALGORITHM_ZSTD":   1,
+	"COMPRESSION_ALGORITHM_BROTLI": 2,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{0}
+}
+
 // MsgDeliverInbound defines an SDK message for delivering an eventual send
 type MsgDeliverInbound struct {
 	Messages  []string                                      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages" yaml:"messages"`
@@ -191,8 +223,10 @@ func (m *MsgWalletAction) GetAction() string {
 	return ""
 }
 
-// MsgWalletActionResponse is an empty reply.
+// MsgWalletActionResponse identifies the enqueued wallet action.
 type MsgWalletActionResponse struct {
+	// Where the action was placed for the SwingSet controller to consume.
+	ActionContext ActionContext `protobuf:"bytes,1,opt,name=action_context,json=actionContext,proto3" json:"actionContext" yaml:"actionContext"`
 }
 
 func (m *MsgWalletActionResponse) Reset()         { *m = MsgWalletActionResponse{} }
@@ -228,6 +262,13 @@ func (m *MsgWalletActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWalletActionResponse proto.InternalMessageInfo
 
+func (m *MsgWalletActionResponse) GetActionContext() ActionContext {
+	if m != nil {
+		return m.ActionContext
+	}
+	return ActionContext{}
+}
+
 // MsgWalletSpendAction defines an SDK message for the on-chain wallet to
 // perform an action that *does spend the owner's assets.*  This message type is
 // typically protected by explicit confirmation by the user.
@@ -284,8 +325,10 @@ func (m *MsgWalletSpendAction) GetSpendAction() string {
 	return ""
 }
 
-// MsgWalletSpendActionResponse is an empty reply.
+// MsgWalletSpendActionResponse identifies the enqueued wallet spend action.
 type MsgWalletSpendActionResponse struct {
+	// Where the action was placed for the SwingSet controller to consume.
+	ActionContext ActionContext `protobuf:"bytes,1,opt,name=action_context,json=actionContext,proto3" json:"actionContext" yaml:"actionContext"`
 }
 
 func (m *MsgWalletSpendActionResponse) Reset()         { *m = MsgWalletSpendActionResponse{} }
@@ -321,6 +364,277 @@ func (m *MsgWalletSpendActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWalletSpendActionResponse proto.InternalMessageInfo
 
+func (m *MsgWalletSpendActionResponse) GetActionContext() ActionContext {
+	if m != nil {
+		return m.ActionContext
+	}
+	return ActionContext{}
+}
+
+// MsgWalletActionBatch defines an SDK message for the on-chain wallet to
+// perform several actions at once.  The actions are admitted (or rejected)
+// together, and are placed on the inbound queue in order, each with the same
+// transaction hash and message index.
+type MsgWalletActionBatch struct {
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
+	// The actions to perform, each as JSON-stringified marshalled data.
+	Actions []string `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// If true, the actions are delivered as spend actions, as if each had been
+	// sent in a MsgWalletSpendAction.
+	Spend bool `protobuf:"varint,3,opt,name=spend,proto3" json:"spend,omitempty"`
+}
+
+func (m *MsgWalletActionBatch) Reset()         { *m = MsgWalletActionBatch{} }
+func (m *MsgWalletActionBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletActionBatch) ProtoMessage()    {}
+func (*MsgWalletActionBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{6}
+}
+func (m *MsgWalletActionBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletActionBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletActionBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletActionBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletActionBatch.Merge(m, src)
+}
+func (m *MsgWalletActionBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletActionBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletActionBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletActionBatch proto.InternalMessageInfo
+
+func (m *MsgWalletActionBatch) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *MsgWalletActionBatch) GetActions() []string {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *MsgWalletActionBatch) GetSpend() bool {
+	if m != nil {
+		return m.Spend
+	}
+	return false
+}
+
+// MsgWalletActionBatchResponse identifies the enqueued wallet actions, in the
+// same order as the actions of the batch.
+type MsgWalletActionBatchResponse struct {
+	ActionContexts []ActionContext `protobuf:"bytes,1,rep,name=action_contexts,json=actionContexts,proto3" json:"actionContexts" yaml:"actionContexts"`
+}
+
+func (m *MsgWalletActionBatchResponse) Reset()         { *m = MsgWalletActionBatchResponse{} }
+func (m *MsgWalletActionBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletActionBatchResponse) ProtoMessage()    {}
+func (*MsgWalletActionBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{7}
+}
+func (m *MsgWalletActionBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletActionBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletActionBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletActionBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletActionBatchResponse.Merge(m, src)
+}
+func (m *MsgWalletActionBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletActionBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletActionBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletActionBatchResponse proto.InternalMessageInfo
+
+func (m *MsgWalletActionBatchResponse) GetActionContexts() []ActionContext {
+	if m != nil {
+		return m.ActionContexts
+	}
+	return nil
+}
+
+// ActionContext identifies an action on one of the controller's inbound
+// queues, along with the transaction message from which it originated.  The
+// tx_hash and msg_idx match the "context" of the inbound queue record seen by
+// the controller.
+type ActionContext struct {
+	// The name of the inbound queue, e.g. "actionQueue" or "highPriorityQueue".
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue" yaml:"queue"`
+	// The index of the action within the inbound queue.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index" yaml:"index"`
+	// The block height in which the action was enqueued.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The hash of the transaction that included the message.
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"txHash" yaml:"txHash"`
+	// The index of the message within the transaction.
+	MsgIdx int64 `protobuf:"varint,5,opt,name=msg_idx,json=msgIdx,proto3" json:"msgIdx" yaml:"msgIdx"`
+}
+
+func (m *ActionContext) Reset()         { *m = ActionContext{} }
+func (m *ActionContext) String() string { return proto.CompactTextString(m) }
+func (*ActionContext) ProtoMessage()    {}
+func (*ActionContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{8}
+}
+func (m *ActionContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionContext.Merge(m, src)
+}
+func (m *ActionContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionContext proto.InternalMessageInfo
+
+func (m *ActionContext) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *ActionContext) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActionContext) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ActionContext) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ActionContext) GetMsgIdx() int64 {
+	if m != nil {
+		return m.MsgIdx
+	}
+	return 0
+}
+
+// EventWalletAction is emitted when a wallet action (spending or not) has
+// been placed on an inbound queue.  Off-chain clients can use it to correlate
+// a transaction with the eventual wallet update published by the controller.
+type EventWalletAction struct {
+	// The bech32 address of the wallet owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Whether the action was a MsgWalletSpendAction.
+	Spend bool `protobuf:"varint,2,opt,name=spend,proto3" json:"spend,omitempty"`
+	// Where the action was enqueued.
+	ActionContext ActionContext `protobuf:"bytes,3,opt,name=action_context,json=actionContext,proto3" json:"action_context"`
+}
+
+func (m *EventWalletAction) Reset()         { *m = EventWalletAction{} }
+func (m *EventWalletAction) String() string { return proto.CompactTextString(m) }
+func (*EventWalletAction) ProtoMessage()    {}
+func (*EventWalletAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{9}
+}
+func (m *EventWalletAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWalletAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWalletAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWalletAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWalletAction.Merge(m, src)
+}
+func (m *EventWalletAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWalletAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWalletAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWalletAction proto.InternalMessageInfo
+
+func (m *EventWalletAction) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventWalletAction) GetSpend() bool {
+	if m != nil {
+		return m.Spend
+	}
+	return false
+}
+
+func (m *EventWalletAction) GetActionContext() ActionContext {
+	if m != nil {
+		return m.ActionContext
+	}
+	return ActionContext{}
+}
+
 // MsgProvision defines an SDK message for provisioning a client to the chain
 type MsgProvision struct {
 	Nickname   string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
@@ -333,7 +647,7 @@ func (m *MsgProvision) Reset()         { *m = MsgProvision{} }
 func (m *MsgProvision) String() string { return proto.CompactTextString(m) }
 func (*MsgProvision) ProtoMessage()    {}
 func (*MsgProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{6}
+	return fileDescriptor_788baa062b181a57, []int{10}
 }
 func (m *MsgProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +712,7 @@ func (m *MsgProvisionResponse) Reset()         { *m = MsgProvisionResponse{} }
 func (m *MsgProvisionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProvisionResponse) ProtoMessage()    {}
 func (*MsgProvisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{7}
+	return fileDescriptor_788baa062b181a57, []int{11}
 }
 func (m *MsgProvisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,17 +746,19 @@ type MsgInstallBundle struct {
 	Bundle    string                                        `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle" yaml:"bundle"`
 	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
 	// Either bundle or compressed_bundle will be set.
-	// Default compression algorithm is gzip.
+	// The compression algorithm is given by the compression field.
 	CompressedBundle []byte `protobuf:"bytes,3,opt,name=compressed_bundle,json=compressedBundle,proto3" json:"compressedBundle" yaml:"compressedBundle"`
 	// Size in bytes of uncompression of compressed_bundle.
 	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
+	// Algorithm used to produce compressed_bundle.  Defaults to gzip.
+	Compression CompressionAlgorithm `protobuf:"varint,5,opt,name=compression,proto3,enum=agoric.swingset.CompressionAlgorithm" json:"compression" yaml:"compression"`
 }
 
 func (m *MsgInstallBundle) Reset()         { *m = MsgInstallBundle{} }
 func (m *MsgInstallBundle) String() string { return proto.CompactTextString(m) }
 func (*MsgInstallBundle) ProtoMessage()    {}
 func (*MsgInstallBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{8}
+	return fileDescriptor_788baa062b181a57, []int{12}
 }
 func (m *MsgInstallBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,6 +815,13 @@ func (m *MsgInstallBundle) GetUncompressedSize() int64 {
 	return 0
 }
 
+func (m *MsgInstallBundle) GetCompression() CompressionAlgorithm {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionGzip
+}
+
 // MsgInstallBundleResponse is an empty acknowledgement that an install bundle
 // message has been queued for the SwingSet kernel's consideration.
 type MsgInstallBundleResponse struct {
@@ -508,7 +831,7 @@ func (m *MsgInstallBundleResponse) Reset()         { *m = MsgInstallBundleRespon
 func (m *MsgInstallBundleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInstallBundleResponse) ProtoMessage()    {}
 func (*MsgInstallBundleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{9}
+	return fileDescriptor_788baa062b181a57, []int{13}
 }
 func (m *MsgInstallBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,12 +861,17 @@ func (m *MsgInstallBundleResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgInstallBundleResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("agoric.swingset.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
 	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
 	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
 	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
 	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
 	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
+	proto.RegisterType((*MsgWalletActionBatch)(nil), "agoric.swingset.MsgWalletActionBatch")
+	proto.RegisterType((*MsgWalletActionBatchResponse)(nil), "agoric.swingset.MsgWalletActionBatchResponse")
+	proto.RegisterType((*ActionContext)(nil), "agoric.swingset.ActionContext")
+	proto.RegisterType((*EventWalletAction)(nil), "agoric.swingset.EventWalletAction")
 	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
 	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
 	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
@@ -553,57 +881,85 @@ func init() {
 func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
 
 var fileDescriptor_788baa062b181a57 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0x50, 0x36, 0xaf, 0xd9, 0x6d, 0x63, 0x95, 0xad, 0xd7, 0x0b, 0x99, 0xac, 0xa5,
-	0x15, 0x01, 0xd4, 0x44, 0xb0, 0xb7, 0xed, 0x29, 0x16, 0x42, 0x5a, 0xa4, 0xa0, 0xc5, 0x2b, 0x84,
-	0x54, 0x81, 0x5a, 0xc7, 0x19, 0x5c, 0x2b, 0xb6, 0xc7, 0xf2, 0x38, 0x2d, 0xed, 0x8d, 0xff, 0x00,
-	0xfe, 0x01, 0x04, 0xff, 0x0d, 0xc7, 0x1e, 0x11, 0x87, 0x51, 0x95, 0x5e, 0x90, 0x8f, 0x3e, 0x72,
-	0x42, 0x9e, 0xf1, 0x8f, 0xfc, 0x82, 0xa2, 0x1e, 0xca, 0x29, 0x79, 0xdf, 0xf7, 0xbd, 0xf7, 0xbe,
-	0x99, 0x79, 0x63, 0x1b, 0x34, 0xcb, 0x21, 0x91, 0x6b, 0x0f, 0xe8, 0xb9, 0x1b, 0x38, 0x14, 0xc7,
-	0x03, 0x9f, 0x3a, 0xb4, 0x1f, 0x46, 0x24, 0x26, 0xca, 0x8e, 0xe0, 0xfa, 0x05, 0xa7, 0xed, 0x39,
-	0xc4, 0x21, 0x9c, 0x1b, 0x64, 0xff, 0x84, 0x4c, 0xff, 0xb9, 0x0e, 0xed, 0x11, 0x75, 0x3e, 0xc5,
-	0x9e, 0x7b, 0x86, 0xa3, 0x57, 0xc1, 0x98, 0xcc, 0x82, 0x89, 0x72, 0x08, 0x0f, 0x7c, 0x4c, 0xa9,
-	0xe5, 0x60, 0xaa, 0x4a, 0x5d, 0xb9, 0xd7, 0x34, 0x50, 0xc2, 0x50, 0x89, 0xa5, 0x0c, 0xed, 0x5c,
-	0x58, 0xbe, 0xf7, 0x52, 0x2f, 0x10, 0xdd, 0x2c, 0x49, 0xe5, 0x23, 0x68, 0x04, 0x33, 0x9f, 0xaa,
-	0xf5, 0xae, 0xdc, 0x6b, 0x18, 0xfb, 0x09, 0x43, 0x3c, 0x4e, 0x19, 0xda, 0x16, 0x49, 0x59, 0xa4,
-	0x9b, 0x1c, 0x54, 0xde, 0x07, 0xd9, 0xb2, 0xa7, 0xaa, 0xdc, 0x95, 0x7a, 0x0d, 0xe3, 0x9d, 0x84,
-	0xa1, 0x2c, 0x4c, 0x19, 0x02, 0x21, 0xb5, 0xec, 0xa9, 0x6e, 0x66, 0x90, 0x12, 0x42, 0x93, 0xce,
-	0xc6, 0xbe, 0x1b, 0xc7, 0x38, 0x52, 0x1b, 0x5d, 0xa9, 0xd7, 0x32, 0xcc, 0x84, 0xa1, 0x0a, 0x4c,
-	0x19, 0xda, 0x15, 0x49, 0x25, 0xa4, 0xff, 0xc5, 0xd0, 0x81, 0xe3, 0xc6, 0xa7, 0xb3, 0x71, 0xdf,
-	0x26, 0xfe, 0xc0, 0x26, 0xd4, 0x27, 0x34, 0xff, 0x39, 0xa0, 0x93, 0xe9, 0x20, 0xbe, 0x08, 0x31,
-	0xed, 0x0f, 0x6d, 0x7b, 0x38, 0x99, 0x44, 0x98, 0x52, 0xb3, 0xaa, 0xf7, 0xb2, 0xf1, 0xe7, 0x2f,
-	0xa8, 0xa6, 0x3f, 0x85, 0x27, 0x6b, 0xfb, 0x63, 0x62, 0x1a, 0x92, 0x80, 0x62, 0xfd, 0x27, 0x09,
-	0x76, 0x46, 0xd4, 0xf9, 0xda, 0xf2, 0x3c, 0x1c, 0x0f, 0xed, 0xd8, 0x25, 0x81, 0x72, 0x02, 0x6f,
-	0x91, 0xf3, 0x00, 0x47, 0xaa, 0xc4, 0x4d, 0x7e, 0x9e, 0x30, 0x24, 0x80, 0x94, 0xa1, 0x96, 0x30,
-	0xc8, 0xc3, 0x3b, 0x98, 0x13, 0x75, 0x94, 0xc7, 0xb0, 0x65, 0xf1, 0x5e, 0x6a, 0xbd, 0x2b, 0xf5,
-	0x9a, 0x66, 0x1e, 0xe5, 0x86, 0x9f, 0xc0, 0xfe, 0x8a, 0xa5, 0xd2, 0xee, 0xaf, 0x12, 0xec, 0x95,
-	0xdc, 0x9b, 0x10, 0x07, 0x93, 0x7b, 0xf3, 0xfc, 0x0c, 0x5a, 0x34, 0x6b, 0x78, 0xbc, 0xe4, 0x7c,
-	0x9b, 0x56, 0x26, 0x72, 0xfb, 0x1d, 0x78, 0x77, 0x93, 0xc5, 0x72, 0x0d, 0x3f, 0xc8, 0xd0, 0x1a,
-	0x51, 0xe7, 0x75, 0x44, 0xce, 0x5c, 0x9a, 0x79, 0x3f, 0x84, 0x07, 0x81, 0x6b, 0x4f, 0x03, 0xcb,
-	0xc7, 0xdc, 0x7e, 0x3e, 0xab, 0x05, 0x56, 0xcd, 0x6a, 0x81, 0xe8, 0x66, 0x49, 0x2a, 0xa7, 0xf0,
-	0xb6, 0x25, 0x8c, 0x72, 0x47, 0x2d, 0xe3, 0x8b, 0x84, 0xa1, 0x02, 0x4a, 0x19, 0x7a, 0x94, 0x8f,
-	0xa1, 0x00, 0xee, 0xb0, 0xfc, 0xa2, 0x96, 0x62, 0xc2, 0x76, 0x48, 0xce, 0x71, 0x74, 0xfc, 0x9d,
-	0x67, 0x39, 0x54, 0x95, 0xf9, 0xad, 0xfa, 0x78, 0xce, 0x10, 0xbc, 0xce, 0xe0, 0xcf, 0x32, 0x34,
-	0x61, 0x08, 0xc2, 0x32, 0x4a, 0x19, 0x6a, 0x8b, 0xf6, 0x15, 0xa6, 0x9b, 0x0b, 0x82, 0xff, 0xed,
-	0x4e, 0x3c, 0xe6, 0x63, 0x54, 0x1e, 0x41, 0x79, 0x36, 0x7f, 0xd4, 0x61, 0x77, 0x44, 0x9d, 0x57,
-	0x01, 0x8d, 0x2d, 0xcf, 0x33, 0x66, 0xc1, 0xc4, 0xc3, 0xca, 0x0b, 0xd8, 0x1a, 0xf3, 0x7f, 0xf9,
-	0xe9, 0x3c, 0x4d, 0x18, 0xca, 0x91, 0x94, 0xa1, 0x87, 0xc2, 0x9e, 0x88, 0x75, 0x33, 0x27, 0x96,
-	0x57, 0x56, 0xbf, 0x87, 0x95, 0x29, 0xdf, 0x40, 0xdb, 0x26, 0x7e, 0x98, 0xc1, 0x78, 0x72, 0x9c,
-	0x3b, 0x96, 0x79, 0xe7, 0x41, 0xc2, 0xd0, 0x6e, 0x45, 0x1a, 0x85, 0xf7, 0x7d, 0x61, 0x60, 0x95,
-	0xd1, 0xcd, 0x35, 0xb1, 0x32, 0x84, 0xf6, 0x2c, 0x58, 0xa8, 0x4f, 0xdd, 0x4b, 0xcc, 0x4f, 0x4c,
-	0x36, 0xf6, 0xb2, 0xea, 0x8b, 0xe4, 0x1b, 0xf7, 0x12, 0x9b, 0x6b, 0x88, 0xae, 0x81, 0xba, 0xba,
-	0xb7, 0xc5, 0xc6, 0x7f, 0x72, 0x2d, 0x83, 0x3c, 0xa2, 0x8e, 0xf2, 0x2d, 0x3c, 0x5c, 0xde, 0xfc,
-	0x67, 0xfd, 0x95, 0xd7, 0x40, 0x7f, 0xb5, 0x86, 0xf6, 0xc1, 0xad, 0x92, 0xa2, 0x8d, 0x72, 0x02,
-	0x8f, 0x56, 0x5e, 0x14, 0xfa, 0xa6, 0xe4, 0x65, 0x8d, 0xf6, 0xe1, 0xed, 0x9a, 0xb2, 0xc3, 0x11,
-	0xb4, 0x96, 0x1e, 0xa6, 0xdd, 0x4d, 0xb9, 0x8b, 0x0a, 0xad, 0x77, 0x9b, 0xa2, 0xac, 0xed, 0x42,
-	0x7b, 0xfd, 0xc9, 0xf7, 0xfc, 0x9f, 0xd3, 0x17, 0x64, 0xda, 0xc1, 0x7f, 0x92, 0x95, 0xad, 0xbe,
-	0x84, 0x66, 0xf5, 0x80, 0x7a, 0x6f, 0x53, 0x6e, 0x49, 0x6b, 0xcf, 0xff, 0x95, 0x2e, 0x4a, 0x1a,
-	0x5f, 0xfd, 0x36, 0xef, 0x48, 0x57, 0xf3, 0x8e, 0x74, 0x3d, 0xef, 0x48, 0x3f, 0xde, 0x74, 0x6a,
-	0x57, 0x37, 0x9d, 0xda, 0xef, 0x37, 0x9d, 0xda, 0xd1, 0xe1, 0xc2, 0xcc, 0x0f, 0xc5, 0x07, 0x81,
-	0xa8, 0xc8, 0x67, 0xde, 0x21, 0x9e, 0x15, 0x38, 0xc5, 0x65, 0xf8, 0xbe, 0xfa, 0x56, 0xe0, 0x97,
-	0x61, 0xbc, 0xc5, 0x3f, 0x03, 0x5e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51, 0x66, 0x1b, 0xd5,
-	0x4b, 0x08, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcb, 0x8f, 0x1b, 0xc5,
+	0x13, 0xf6, 0x78, 0xbc, 0x9b, 0xb8, 0xd7, 0xfb, 0xf0, 0xfc, 0x9c, 0xc4, 0x99, 0xe4, 0xe7, 0x76,
+	0x5a, 0x5a, 0xb1, 0x04, 0xad, 0x2d, 0x12, 0x24, 0xa4, 0xe4, 0xe4, 0x49, 0x42, 0xd6, 0x10, 0x67,
+	0x97, 0xde, 0x20, 0xa4, 0x15, 0xc8, 0x19, 0x8f, 0x9b, 0xf1, 0x68, 0xe7, 0x61, 0xdc, 0xe3, 0xc4,
+	0xc9, 0x8d, 0x1b, 0x8a, 0x90, 0x00, 0x89, 0x03, 0x97, 0x20, 0x10, 0x07, 0x6e, 0xfc, 0x0b, 0x5c,
+	0x23, 0xb8, 0xe4, 0xc8, 0xa9, 0x85, 0x36, 0x17, 0xe4, 0xa3, 0x8f, 0x9c, 0xd0, 0x74, 0xcf, 0xcb,
+	0x8f, 0xb0, 0x51, 0x0e, 0xc9, 0xc9, 0xae, 0xaf, 0xea, 0xab, 0xae, 0xf9, 0xba, 0xba, 0x7a, 0x06,
+	0xa8, 0xba, 0xe9, 0x0d, 0x2c, 0xa3, 0x4e, 0xef, 0x5b, 0xae, 0x49, 0x89, 0x5f, 0x77, 0xa8, 0x49,
+	0x6b, 0xfd, 0x81, 0xe7, 0x7b, 0xca, 0xba, 0xf0, 0xd5, 0x22, 0x9f, 0x5a, 0x32, 0x3d, 0xd3, 0xe3,
+	0xbe, 0x7a, 0xf0, 0x4f, 0x84, 0xa1, 0x1f, 0xb2, 0xa0, 0xd8, 0xa2, 0xe6, 0x75, 0x62, 0x5b, 0xf7,
+	0xc8, 0xa0, 0xe9, 0x76, 0xbc, 0xa1, 0xdb, 0x55, 0xae, 0x82, 0x93, 0x0e, 0xa1, 0x54, 0x37, 0x09,
+	0x2d, 0x4b, 0x55, 0x79, 0x2b, 0xaf, 0xc1, 0x31, 0x83, 0x31, 0x36, 0x61, 0x70, 0xfd, 0x81, 0xee,
+	0xd8, 0x57, 0x50, 0x84, 0x20, 0x1c, 0x3b, 0x95, 0xb7, 0x40, 0xce, 0x1d, 0x3a, 0xb4, 0x9c, 0xad,
+	0xca, 0x5b, 0x39, 0xed, 0xcc, 0x98, 0x41, 0x6e, 0x4f, 0x18, 0x5c, 0x11, 0xa4, 0xc0, 0x42, 0x98,
+	0x83, 0xca, 0x1b, 0x40, 0xd6, 0x8d, 0xc3, 0xb2, 0x5c, 0x95, 0xb6, 0x72, 0xda, 0xa9, 0x31, 0x83,
+	0x81, 0x39, 0x61, 0x10, 0x88, 0x50, 0xdd, 0x38, 0x44, 0x38, 0x80, 0x94, 0x3e, 0xc8, 0xd3, 0x61,
+	0xc7, 0xb1, 0x7c, 0x9f, 0x0c, 0xca, 0xb9, 0xaa, 0xb4, 0x55, 0xd0, 0xf0, 0x98, 0xc1, 0x04, 0x9c,
+	0x30, 0xb8, 0x21, 0x48, 0x31, 0x84, 0xfe, 0x61, 0x70, 0xdb, 0xb4, 0xfc, 0xde, 0xb0, 0x53, 0x33,
+	0x3c, 0xa7, 0x6e, 0x78, 0xd4, 0xf1, 0x68, 0xf8, 0xb3, 0x4d, 0xbb, 0x87, 0x75, 0xff, 0x41, 0x9f,
+	0xd0, 0x5a, 0xc3, 0x30, 0x1a, 0xdd, 0xee, 0x80, 0x50, 0x8a, 0x93, 0x7c, 0x57, 0x72, 0x7f, 0xff,
+	0x08, 0x33, 0xe8, 0x1c, 0x38, 0x3b, 0xa7, 0x0f, 0x26, 0xb4, 0xef, 0xb9, 0x94, 0xa0, 0x6f, 0x25,
+	0xb0, 0xde, 0xa2, 0xe6, 0xc7, 0xba, 0x6d, 0x13, 0xbf, 0x61, 0xf8, 0x96, 0xe7, 0x2a, 0x77, 0xc1,
+	0x92, 0x77, 0xdf, 0x25, 0x83, 0xb2, 0xc4, 0x8b, 0x7c, 0x7f, 0xcc, 0xa0, 0x00, 0x26, 0x0c, 0x16,
+	0x44, 0x81, 0xdc, 0x7c, 0x89, 0xe2, 0x44, 0x1e, 0xe5, 0x34, 0x58, 0xd6, 0xf9, 0x5a, 0xe5, 0x6c,
+	0x55, 0xda, 0xca, 0xe3, 0xd0, 0x0a, 0x0b, 0xfe, 0x5a, 0x02, 0x67, 0x66, 0x6a, 0x8a, 0xea, 0x55,
+	0x7c, 0xb0, 0x26, 0x62, 0xdb, 0x86, 0xe7, 0xfa, 0x64, 0xe4, 0xf3, 0x22, 0x57, 0x2e, 0x55, 0x6a,
+	0x33, 0xdd, 0x52, 0x13, 0xc4, 0x6b, 0x22, 0x4a, 0xdb, 0x7e, 0xc2, 0x60, 0x66, 0xcc, 0xe0, 0xaa,
+	0x9e, 0x86, 0x27, 0x0c, 0x96, 0xa2, 0x6d, 0x4a, 0xc1, 0x08, 0x4f, 0x87, 0xa1, 0x9f, 0x24, 0x50,
+	0x8a, 0x2b, 0xda, 0xef, 0x13, 0xb7, 0xfb, 0xca, 0xa4, 0xba, 0x00, 0x0a, 0x34, 0x58, 0xb0, 0x3d,
+	0x25, 0xd8, 0x0a, 0x4d, 0x8a, 0x08, 0x55, 0xfb, 0x4e, 0x02, 0xe7, 0x17, 0xd5, 0xf8, 0x9a, 0xa5,
+	0xfb, 0x35, 0x2d, 0x9d, 0x48, 0xac, 0xe9, 0xbe, 0xd1, 0x7b, 0x05, 0xd2, 0x95, 0xc1, 0x09, 0x51,
+	0x8b, 0x38, 0xc9, 0x79, 0x1c, 0x99, 0x4a, 0x09, 0x2c, 0x71, 0x01, 0xf9, 0xa9, 0x3d, 0x89, 0x85,
+	0x11, 0xea, 0xf8, 0x7d, 0x5a, 0xc7, 0x54, 0xc1, 0xb1, 0x8e, 0x23, 0xb0, 0x3e, 0xad, 0xa3, 0x98,
+	0x30, 0xc7, 0x0b, 0x59, 0x0f, 0x85, 0x5c, 0x9b, 0x52, 0x28, 0x18, 0x2b, 0xa7, 0x16, 0x28, 0x49,
+	0x11, 0x9e, 0x09, 0x44, 0xbf, 0x64, 0xc1, 0xea, 0x54, 0x4a, 0xa5, 0x0e, 0x96, 0x3e, 0x1f, 0x92,
+	0x21, 0xe1, 0x22, 0xe6, 0xb5, 0xb3, 0x81, 0x88, 0x1c, 0x48, 0x44, 0xe4, 0x26, 0xc2, 0x02, 0x0e,
+	0x08, 0x96, 0xdb, 0x25, 0x23, 0xde, 0x47, 0x39, 0x41, 0xe0, 0x40, 0x42, 0xe0, 0x26, 0xc2, 0x02,
+	0x56, 0x76, 0x40, 0xa1, 0x63, 0x7b, 0xc6, 0x61, 0xbb, 0x47, 0x2c, 0xb3, 0xe7, 0x73, 0xc5, 0x64,
+	0x6d, 0x73, 0xcc, 0xe0, 0x0a, 0xc7, 0x77, 0x38, 0x3c, 0x61, 0x50, 0x11, 0xec, 0x14, 0x88, 0x70,
+	0x3a, 0x44, 0x79, 0x07, 0x9c, 0xf0, 0x47, 0xed, 0x9e, 0x4e, 0x7b, 0x7c, 0xfa, 0xe5, 0xb5, 0x73,
+	0x63, 0x06, 0x97, 0xfd, 0xd1, 0x8e, 0x4e, 0x7b, 0x13, 0x06, 0x57, 0x05, 0x5f, 0xd8, 0x08, 0x87,
+	0x8e, 0x80, 0xe5, 0x50, 0xb3, 0x6d, 0x75, 0x47, 0xe5, 0x25, 0xbe, 0x34, 0x67, 0x39, 0xd4, 0x6c,
+	0x76, 0x47, 0x09, 0x4b, 0xd8, 0x08, 0x87, 0x0e, 0xf4, 0x95, 0x04, 0x8a, 0x37, 0xee, 0x11, 0xd7,
+	0x9f, 0x1a, 0x6c, 0xa5, 0x74, 0xcb, 0xe5, 0xa3, 0x36, 0x89, 0x9b, 0x21, 0x9b, 0x6a, 0x06, 0xe5,
+	0x83, 0xb9, 0xd3, 0x22, 0xbf, 0xd0, 0x69, 0xc9, 0x05, 0x9b, 0x3c, 0x7b, 0x08, 0xbe, 0x90, 0x41,
+	0xa1, 0x45, 0xcd, 0xbd, 0x81, 0x77, 0xcf, 0xa2, 0x41, 0x25, 0x57, 0xc1, 0x49, 0xd7, 0x32, 0x0e,
+	0x5d, 0xdd, 0x89, 0xb6, 0x8e, 0x5f, 0x4f, 0x11, 0x96, 0x5c, 0x4f, 0x11, 0x82, 0x70, 0xec, 0x54,
+	0x7a, 0xe0, 0x84, 0x2e, 0x3a, 0x9d, 0x97, 0x5c, 0xd0, 0x6e, 0x8f, 0x19, 0x8c, 0xa0, 0x09, 0x83,
+	0x6b, 0x61, 0x37, 0x09, 0xe0, 0x25, 0xce, 0x4f, 0x94, 0x4b, 0xc1, 0x60, 0xa5, 0xef, 0xdd, 0x27,
+	0x83, 0xf6, 0x67, 0xb6, 0x6e, 0xd2, 0xb2, 0xcc, 0x2f, 0xd2, 0xb7, 0x8f, 0x18, 0x04, 0x7b, 0x01,
+	0xfc, 0x5e, 0x80, 0x8e, 0x19, 0x04, 0xfd, 0xd8, 0x9a, 0x30, 0x58, 0x14, 0xcb, 0x27, 0x18, 0xc2,
+	0xa9, 0x80, 0xd7, 0x76, 0x0d, 0x9e, 0x06, 0xa5, 0xf4, 0x16, 0xc4, 0x37, 0xe0, 0xef, 0x32, 0xd8,
+	0x68, 0x51, 0xb3, 0xe9, 0x52, 0x5f, 0xb7, 0x6d, 0x6d, 0xe8, 0x76, 0x6d, 0xa2, 0x5c, 0x06, 0xcb,
+	0x1d, 0xfe, 0x2f, 0xdc, 0x1d, 0xde, 0x74, 0x02, 0x49, 0x9a, 0x4e, 0xd8, 0x08, 0x87, 0x8e, 0xe9,
+	0x27, 0xcb, 0xbe, 0x82, 0x27, 0x53, 0x3e, 0x01, 0x45, 0xc3, 0x73, 0xfa, 0x01, 0x4c, 0xba, 0xed,
+	0xb0, 0x62, 0x99, 0xaf, 0x5c, 0x1f, 0x33, 0xb8, 0x91, 0x38, 0xb5, 0xa8, 0xf6, 0x33, 0xa2, 0x80,
+	0x59, 0x0f, 0xc2, 0x73, 0xc1, 0x4a, 0x03, 0x14, 0x87, 0x6e, 0x2a, 0x3f, 0xb5, 0x1e, 0x12, 0xbe,
+	0x63, 0xb2, 0x56, 0x0a, 0xb2, 0xa7, 0x9d, 0xfb, 0xd6, 0x43, 0x82, 0xe7, 0x10, 0xc5, 0x05, 0x2b,
+	0x11, 0x12, 0x5c, 0x5e, 0xc1, 0x09, 0x5e, 0xbb, 0xb4, 0x39, 0x77, 0x84, 0xae, 0x25, 0x31, 0x0d,
+	0x3b, 0xf0, 0xf9, 0x3d, 0x47, 0xcc, 0x98, 0x14, 0x3b, 0x99, 0x31, 0x29, 0x10, 0xe1, 0x74, 0x08,
+	0x52, 0x41, 0x79, 0x76, 0x2f, 0xa3, 0x8d, 0xbe, 0xf8, 0x9b, 0x04, 0x4a, 0x8b, 0x16, 0x52, 0x2e,
+	0x03, 0xf5, 0xda, 0x6e, 0x6b, 0x0f, 0xdf, 0xd8, 0xdf, 0x6f, 0xee, 0xde, 0x6e, 0x37, 0x6e, 0xdd,
+	0xdc, 0xc5, 0xcd, 0x3b, 0x3b, 0xad, 0xf6, 0xcd, 0x83, 0xe6, 0xde, 0x46, 0x46, 0xfd, 0xdf, 0xa3,
+	0xc7, 0xd5, 0xf5, 0x14, 0xf3, 0xe6, 0x43, 0xab, 0xff, 0x7c, 0xd2, 0xc1, 0xfe, 0x9d, 0xeb, 0x1b,
+	0xd2, 0x1c, 0xe9, 0x80, 0xfa, 0x5d, 0xe5, 0x5d, 0x70, 0x7e, 0x31, 0x49, 0xc3, 0xbb, 0x77, 0x6e,
+	0x35, 0x37, 0xb2, 0xea, 0xa9, 0x47, 0x8f, 0xab, 0xc5, 0x14, 0x4d, 0x1b, 0x78, 0xbe, 0x6d, 0xa9,
+	0xb9, 0x2f, 0x7f, 0xae, 0x64, 0x2e, 0xfd, 0x91, 0x03, 0x72, 0x8b, 0x9a, 0xca, 0xa7, 0x60, 0x75,
+	0xba, 0x5d, 0x2f, 0xcc, 0x29, 0x3a, 0xab, 0x82, 0xfa, 0xe6, 0xb1, 0x21, 0xf1, 0x05, 0x77, 0x17,
+	0xac, 0xcd, 0xbc, 0x4d, 0xa3, 0x45, 0xe4, 0xe9, 0x18, 0xf5, 0xe2, 0xf1, 0x31, 0xf1, 0x0a, 0x07,
+	0xa0, 0x30, 0x35, 0x98, 0xab, 0x8b, 0xb8, 0xe9, 0x08, 0x75, 0xeb, 0xb8, 0x88, 0x38, 0xb7, 0x05,
+	0x8a, 0xf3, 0xef, 0x69, 0x9b, 0xcf, 0xa7, 0xa7, 0xc2, 0xd4, 0xed, 0x17, 0x0a, 0x9b, 0x5f, 0x2a,
+	0xfd, 0x5e, 0xb3, 0x79, 0x5c, 0xa5, 0x3c, 0x4c, 0xdd, 0x7e, 0xa1, 0xb0, 0x78, 0xa9, 0x0f, 0x41,
+	0x3e, 0xb9, 0x3d, 0xfe, 0xbf, 0x88, 0x1b, 0xbb, 0xd5, 0xcd, 0xff, 0x74, 0x47, 0x29, 0xb5, 0x8f,
+	0x9e, 0x1c, 0x55, 0xa4, 0xa7, 0x47, 0x15, 0xe9, 0xaf, 0xa3, 0x8a, 0xf4, 0xcd, 0xb3, 0x4a, 0xe6,
+	0xe9, 0xb3, 0x4a, 0xe6, 0xcf, 0x67, 0x95, 0xcc, 0xc1, 0xd5, 0xd4, 0x40, 0x6a, 0x88, 0x0f, 0x34,
+	0x91, 0x91, 0x0f, 0x24, 0xd3, 0xb3, 0x75, 0xd7, 0x8c, 0x26, 0xd5, 0x28, 0xf9, 0x76, 0xe3, 0x93,
+	0xaa, 0xb3, 0xcc, 0x3f, 0xcb, 0x2e, 0xff, 0x3b, 0x00, 0x92, 0x26, 0x60, 0xa1, 0xdb, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +982,8 @@ type MsgClient interface {
 	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
 	// Perform a wallet action that spends assets.
 	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
+	// Perform several wallet actions, admitted atomically.
+	WalletActionBatch(ctx context.Context, in *MsgWalletActionBatch, opts ...grpc.CallOption) (*MsgWalletActionBatchResponse, error)
 	// Provision a new endpoint.
 	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
 }
@@ -674,6 +1032,15 @@ func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAct
 	return out, nil
 }
 
+func (c *msgClient) WalletActionBatch(ctx context.Context, in *MsgWalletActionBatch, opts ...grpc.CallOption) (*MsgWalletActionBatchResponse, error) {
+	out := new(MsgWalletActionBatchResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletActionBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
 	out := new(MsgProvisionResponse)
 	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
@@ -693,6 +1060,8 @@ type MsgServer interface {
 	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
 	// Perform a wallet action that spends assets.
 	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
+	// Perform several wallet actions, admitted atomically.
+	WalletActionBatch(context.Context, *MsgWalletActionBatch) (*MsgWalletActionBatchResponse, error)
 	// Provision a new endpoint.
 	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
 }
@@ -713,6 +1082,9 @@ func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletA
 func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
 }
+func (*UnimplementedMsgServer) WalletActionBatch(ctx context.Context, req *MsgWalletActionBatch) (*MsgWalletActionBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletActionBatch not implemented")
+}
 func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
 }
@@ -793,6 +1165,24 @@ func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WalletActionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletActionBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WalletActionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletActionBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletActionBatch(ctx, req.(*MsgWalletActionBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgProvision)
 	if err := dec(in); err != nil {
@@ -831,6 +1221,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WalletSpendAction",
 			Handler:    _Msg_WalletSpendAction_Handler,
 		},
+		{
+			MethodName: "WalletActionBatch",
+			Handler:    _Msg_WalletActionBatch_Handler,
+		},
 		{
 			MethodName: "Provision",
 			Handler:    _Msg_Provision_Handler,
@@ -982,6 +1376,16 @@ func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ActionContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1042,10 +1446,20 @@ func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ActionContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
+func (m *MsgWalletActionBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1055,50 +1469,46 @@ func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWalletActionBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWalletActionBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+	if m.Spend {
 		i--
-		dAtA[i] = 0x22
+		if m.Spend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actions[iNdEx])
+			copy(dAtA[i:], m.Actions[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Actions[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWalletActionBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1108,20 +1518,34 @@ func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWalletActionBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWalletActionBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActionContexts) > 0 {
+		for iNdEx := len(m.ActionContexts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionContexts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
+func (m *ActionContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1131,30 +1555,213 @@ func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActionContext) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActionContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UncompressedSize != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+	if m.MsgIdx != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MsgIdx))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
-	if len(m.CompressedBundle) > 0 {
-		i -= len(m.CompressedBundle)
-		copy(dAtA[i:], m.CompressedBundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWalletAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWalletAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ActionContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Spend {
+		i--
+		if m.Spend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compression != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CompressedBundle) > 0 {
+		i -= len(m.CompressedBundle)
+		copy(dAtA[i:], m.CompressedBundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
 		copy(dAtA[i:], m.Submitter)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
 		i--
@@ -1265,6 +1872,8 @@ func (m *MsgWalletActionResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.ActionContext.Size()
+	n += 1 + l + sovMsgs(uint64(l))
 	return n
 }
 
@@ -1291,6 +1900,89 @@ func (m *MsgWalletSpendActionResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.ActionContext.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgWalletActionBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, s := range m.Actions {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if m.Spend {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWalletActionBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActionContexts) > 0 {
+		for _, e := range m.ActionContexts {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActionContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovMsgs(uint64(m.Index))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.MsgIdx != 0 {
+		n += 1 + sovMsgs(uint64(m.MsgIdx))
+	}
+	return n
+}
+
+func (m *EventWalletAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Spend {
+		n += 2
+	}
+	l = m.ActionContext.Size()
+	n += 1 + l + sovMsgs(uint64(l))
 	return n
 }
 
@@ -1351,6 +2043,9 @@ func (m *MsgInstallBundle) Size() (n int) {
 	if m.UncompressedSize != 0 {
 		n += 1 + sovMsgs(uint64(m.UncompressedSize))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovMsgs(uint64(m.Compression))
+	}
 	return n
 }
 
@@ -1775,6 +2470,39 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1941,17 +2669,576 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spend = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionContexts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionContexts = append(m.ActionContexts, ActionContext{})
+			if err := m.ActionContexts[len(m.ActionContexts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIdx", wireType)
+			}
+			m.MsgIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIdx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWalletAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWalletAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spend = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2342,6 +3629,25 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
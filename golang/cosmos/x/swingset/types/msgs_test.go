@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -92,6 +93,56 @@ func TestWalletSpendAction(t *testing.T) {
 	}
 }
 
+func TestWalletActionBatch(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgWalletActionBatch
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgWalletActionBatch{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgWalletActionBatch(addr, []string{"null", "{}"}, false),
+		},
+		{
+			name: "spend",
+			msg:  NewMsgWalletActionBatch(addr, []string{"null"}, true),
+		},
+		{
+			name:      "no actions",
+			msg:       NewMsgWalletActionBatch(addr, []string{}, false),
+			shouldErr: true,
+		},
+		{
+			name:      "empty action",
+			msg:       NewMsgWalletActionBatch(addr, []string{"null", ""}, false),
+			shouldErr: true,
+		},
+		{
+			name:      "bad json",
+			msg:       NewMsgWalletActionBatch(addr, []string{"foo", "null"}, true),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+			if count := tt.msg.GetInboundMsgCount(); count != int32(len(tt.msg.Actions)) {
+				t.Errorf("got inbound msg count %d, want %d", count, len(tt.msg.Actions))
+			}
+		})
+	}
+}
+
 func TestInstallBundle_ValidateBasic(t *testing.T) {
 	for _, tt := range []struct {
 		name      string
@@ -129,6 +180,34 @@ func TestInstallBundle_ValidateBasic(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			name: "compressed zstd",
+			msg: &MsgInstallBundle{
+				Submitter:        addr,
+				CompressedBundle: []byte{1, 2, 3},
+				UncompressedSize: 4,
+				Compression:      CompressionZstd,
+			},
+		},
+		{
+			name: "unknown compression",
+			msg: &MsgInstallBundle{
+				Submitter:        addr,
+				CompressedBundle: []byte{1, 2, 3},
+				UncompressedSize: 4,
+				Compression:      CompressionAlgorithm(99),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "uncompressed with compression",
+			msg: &MsgInstallBundle{
+				Bundle:      "foo",
+				Submitter:   addr,
+				Compression: CompressionBrotli,
+			},
+			shouldErr: true,
+		},
 		{
 			name: "zero size",
 			msg: &MsgInstallBundle{
@@ -262,3 +341,58 @@ func TestInstallBundle_Compress(t *testing.T) {
 		t.Errorf("wanted Uncompress error for high uncompressed size")
 	}
 }
+
+func TestInstallBundle_CompressionAlgorithms(t *testing.T) {
+	text := strings.Repeat("Lorem ipsum dolor sit amet", 100)
+	for _, name := range []string{"gzip", "zstd", "brotli"} {
+		t.Run(name, func(t *testing.T) {
+			algo, err := ParseCompressionAlgorithm(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if algo.ShortName() != name {
+				t.Errorf("want short name %s, got %s", name, algo.ShortName())
+			}
+			msg := NewMsgInstallBundle(text, addr)
+			msg.Compression = algo
+			err = msg.Compress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(msg.CompressedBundle) >= len(text) {
+				t.Errorf("compressed bundle not smaller: %d >= %d", len(msg.CompressedBundle), len(text))
+			}
+			compressedMsg := proto.Clone(msg).(*MsgInstallBundle)
+			err = msg.Uncompress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if msg.Bundle != text {
+				t.Errorf("round-trip got %q, want %q", msg.Bundle, text)
+			}
+			if msg.Compression != CompressionGzip {
+				t.Errorf("want compression reset, got %s", msg.Compression)
+			}
+			msgModLo := proto.Clone(compressedMsg).(*MsgInstallBundle)
+			msgModLo.UncompressedSize--
+			err = msgModLo.Uncompress()
+			if err == nil {
+				t.Errorf("wanted Uncompress error for low uncompressed size")
+			}
+			msgModHi := proto.Clone(compressedMsg).(*MsgInstallBundle)
+			msgModHi.UncompressedSize++
+			err = msgModHi.Uncompress()
+			if err == nil {
+				t.Errorf("wanted Uncompress error for high uncompressed size")
+			}
+		})
+	}
+	_, err := ParseCompressionAlgorithm("lzma")
+	if err == nil {
+		t.Errorf("wanted error for unknown compression algorithm")
+	}
+}
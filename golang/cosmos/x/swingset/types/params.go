@@ -11,11 +11,14 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyBeansPerUnit       = []byte("beans_per_unit")
-	ParamStoreKeyBootstrapVatConfig = []byte("bootstrap_vat_config")
-	ParamStoreKeyFeeUnitPrice       = []byte("fee_unit_price")
-	ParamStoreKeyPowerFlagFees      = []byte("power_flag_fees")
-	ParamStoreKeyQueueMax           = []byte("queue_max")
+	ParamStoreKeyBeansPerUnit             = []byte("beans_per_unit")
+	ParamStoreKeyBootstrapVatConfig       = []byte("bootstrap_vat_config")
+	ParamStoreKeyFeeUnitPrice             = []byte("fee_unit_price")
+	ParamStoreKeyPowerFlagFees            = []byte("power_flag_fees")
+	ParamStoreKeyQueueMax                 = []byte("queue_max")
+	ParamStoreKeyMaxInboundPerTx          = []byte("max_inbound_per_tx")
+	ParamStoreKeyInboundMempoolQueueRatio = []byte("inbound_mempool_queue_ratio")
+	ParamStoreKeyExportDataBlockBudget    = []byte("export_data_block_budget")
 )
 
 func NewStringBeans(key string, beans sdk.Uint) StringBeans {
@@ -47,11 +50,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default swingset parameters
 func DefaultParams() Params {
 	return Params{
-		BeansPerUnit:       DefaultBeansPerUnit(),
-		BootstrapVatConfig: DefaultBootstrapVatConfig,
-		FeeUnitPrice:       DefaultFeeUnitPrice,
-		PowerFlagFees:      DefaultPowerFlagFees,
-		QueueMax:           DefaultQueueMax,
+		BeansPerUnit:             DefaultBeansPerUnit(),
+		BootstrapVatConfig:       DefaultBootstrapVatConfig,
+		FeeUnitPrice:             DefaultFeeUnitPrice,
+		PowerFlagFees:            DefaultPowerFlagFees,
+		QueueMax:                 DefaultQueueMax,
+		MaxInboundPerTx:          DefaultMaxInboundPerTx,
+		InboundMempoolQueueRatio: DefaultInboundMempoolQueueRatio,
+		ExportDataBlockBudget:    DefaultExportDataBlockBudget,
 	}
 }
 
@@ -68,6 +74,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBootstrapVatConfig, &p.BootstrapVatConfig, validateBootstrapVatConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyPowerFlagFees, &p.PowerFlagFees, validatePowerFlagFees),
 		paramtypes.NewParamSetPair(ParamStoreKeyQueueMax, &p.QueueMax, validateQueueMax),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxInboundPerTx, &p.MaxInboundPerTx, validateMaxInboundPerTx),
+		paramtypes.NewParamSetPair(ParamStoreKeyInboundMempoolQueueRatio, &p.InboundMempoolQueueRatio, validateInboundMempoolQueueRatio),
+		paramtypes.NewParamSetPair(ParamStoreKeyExportDataBlockBudget, &p.ExportDataBlockBudget, validateExportDataBlockBudget),
 	}
 }
 
@@ -88,6 +97,15 @@ func (p Params) ValidateBasic() error {
 	if err := validateQueueMax(p.QueueMax); err != nil {
 		return err
 	}
+	if err := validateMaxInboundPerTx(p.MaxInboundPerTx); err != nil {
+		return err
+	}
+	if err := validateInboundMempoolQueueRatio(p.InboundMempoolQueueRatio); err != nil {
+		return err
+	}
+	if err := validateExportDataBlockBudget(p.ExportDataBlockBudget); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -164,10 +182,48 @@ func validateQueueMax(i interface{}) error {
 	return nil
 }
 
+func validateMaxInboundPerTx(i interface{}) error {
+	v, ok := i.(int32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max inbound per tx must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateInboundMempoolQueueRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inbound mempool queue ratio must not be nil")
+	}
+	if !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inbound mempool queue ratio must be in (0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateExportDataBlockBudget(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 // UpdateParams appends any missing params, configuring them to their defaults,
 // then returning the updated params or an error. Existing params are not
 // modified, regardless of their value, and they are not removed if they no
-// longer appear in the defaults.
+// longer appear in the defaults. Scalar params which are unset (zero) are
+// given their default value.
 func UpdateParams(params Params) (Params, error) {
 	newBpu, err := appendMissingDefaultBeansPerUnit(params.BeansPerUnit, DefaultBeansPerUnit())
 	if err != nil {
@@ -185,6 +241,12 @@ func UpdateParams(params Params) (Params, error) {
 	params.BeansPerUnit = newBpu
 	params.PowerFlagFees = newPff
 	params.QueueMax = newQm
+	if params.MaxInboundPerTx == 0 {
+		params.MaxInboundPerTx = DefaultMaxInboundPerTx
+	}
+	if params.InboundMempoolQueueRatio.IsNil() {
+		params.InboundMempoolQueueRatio = DefaultInboundMempoolQueueRatio
+	}
 	return params, nil
 }
 
@@ -100,11 +100,13 @@ func TestUpdateParams(t *testing.T) {
 		QueueMax:           []QueueSize{},
 	}
 	want := Params{
-		BeansPerUnit:       DefaultBeansPerUnit(),
-		BootstrapVatConfig: "baz",
-		FeeUnitPrice:       sdk.NewCoins(sdk.NewInt64Coin("denom", 789)),
-		PowerFlagFees:      DefaultPowerFlagFees,
-		QueueMax:           DefaultQueueMax,
+		BeansPerUnit:             DefaultBeansPerUnit(),
+		BootstrapVatConfig:       "baz",
+		FeeUnitPrice:             sdk.NewCoins(sdk.NewInt64Coin("denom", 789)),
+		PowerFlagFees:            DefaultPowerFlagFees,
+		QueueMax:                 DefaultQueueMax,
+		MaxInboundPerTx:          DefaultMaxInboundPerTx,
+		InboundMempoolQueueRatio: DefaultInboundMempoolQueueRatio,
 	}
 	got, err := UpdateParams(in)
 	if err != nil {
@@ -114,3 +116,33 @@ func TestUpdateParams(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestValidateInboundAdmissionParams(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		perTx     int32
+		ratio     sdk.Dec
+		shouldErr bool
+	}{
+		{name: "default", perTx: DefaultMaxInboundPerTx, ratio: DefaultInboundMempoolQueueRatio},
+		{name: "full-ratio", perTx: 10, ratio: sdk.OneDec()},
+		{name: "zero-per-tx", perTx: 0, ratio: DefaultInboundMempoolQueueRatio, shouldErr: true},
+		{name: "negative-per-tx", perTx: -1, ratio: DefaultInboundMempoolQueueRatio, shouldErr: true},
+		{name: "zero-ratio", perTx: 1, ratio: sdk.ZeroDec(), shouldErr: true},
+		{name: "over-one-ratio", perTx: 1, ratio: sdk.NewDecWithPrec(11, 1), shouldErr: true},
+		{name: "nil-ratio", perTx: 1, ratio: sdk.Dec{}, shouldErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.MaxInboundPerTx = tt.perTx
+			params.InboundMempoolQueueRatio = tt.ratio
+			err := params.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Errorf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Errorf("wanted validation error")
+			}
+		})
+	}
+}
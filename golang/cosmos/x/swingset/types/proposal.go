@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"regexp"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -11,14 +13,25 @@ import (
 const (
 	// ProposalTypeCoreEval defines the type for a CoreEvalProposal
 	ProposalTypeCoreEval = "CoreEval"
+	// ProposalTypeHighPrioritySenders defines the type for a HighPrioritySendersProposal
+	ProposalTypeHighPrioritySenders = "HighPrioritySenders"
 )
 
 var (
 	_ govv1beta1.Content = &CoreEvalProposal{}
+	_ govv1beta1.Content = &HighPrioritySendersProposal{}
+
+	// senderNamespaceRegexp matches PRIORITY_SENDERS_NAMESPACE_RE in
+	// packages/internal/src/priority-senders.js
+	senderNamespaceRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,50}$`)
+	// senderNamespaceReplacer matches the replacements done by
+	// normalizeSenderNamespace in packages/internal/src/priority-senders.js
+	senderNamespaceReplacer = strings.NewReplacer(" ", "_", ",", "_", "(", "_", ")", "_")
 )
 
 func init() {
 	govv1beta1.RegisterProposalType(ProposalTypeCoreEval)
+	govv1beta1.RegisterProposalType(ProposalTypeHighPrioritySenders)
 }
 
 // NewCoreEvalProposal creates a new core eval proposal.
@@ -76,3 +89,67 @@ func (ce CoreEval) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NormalizeSenderNamespace returns the normalized form of a high priority
+// senders namespace, in the same way as the JS priority senders manager, or an
+// error if the namespace is invalid.
+func NormalizeSenderNamespace(namespace string) (string, error) {
+	candidate := senderNamespaceReplacer.Replace(namespace)
+	if !senderNamespaceRegexp.MatchString(candidate) {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid namespace %q", namespace)
+	}
+	return candidate, nil
+}
+
+// NewHighPrioritySendersProposal creates a new high priority senders proposal.
+func NewHighPrioritySendersProposal(title, description, namespace string, add, remove []string) govv1beta1.Content {
+	return &HighPrioritySendersProposal{
+		Title:       title,
+		Description: description,
+		Namespace:   namespace,
+		Add:         add,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of a high priority senders proposal.
+func (hpsp *HighPrioritySendersProposal) GetTitle() string { return hpsp.Title }
+
+// GetDescription returns the description of a high priority senders proposal.
+func (hpsp *HighPrioritySendersProposal) GetDescription() string { return hpsp.Description }
+
+// ProposalRoute returns the routing key of a high priority senders proposal.
+func (hpsp *HighPrioritySendersProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a high priority senders proposal.
+func (hpsp *HighPrioritySendersProposal) ProposalType() string {
+	return ProposalTypeHighPrioritySenders
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (hpsp *HighPrioritySendersProposal) ValidateBasic() error {
+	err := govv1beta1.ValidateAbstract(hpsp)
+	if err != nil {
+		return err
+	}
+
+	if _, err := NormalizeSenderNamespace(hpsp.Namespace); err != nil {
+		return err
+	}
+	if len(hpsp.Add) == 0 && len(hpsp.Remove) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no senders to add or remove")
+	}
+
+	seen := make(map[string]bool, len(hpsp.Add)+len(hpsp.Remove))
+	for _, addr := range append(append([]string{}, hpsp.Add...), hpsp.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %q: %s", addr, err)
+		}
+		if seen[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate sender %q", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,3 +89,57 @@ evals:
 	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce5})
 	require.Error(t, cep.ValidateBasic())
 }
+
+func TestHighPrioritySendersProposal(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+
+	hpsp := NewHighPrioritySendersProposal("test title", "test description", "oracles", []string{addr1}, []string{addr2})
+	require.Equal(t, "test title", hpsp.GetTitle())
+	require.Equal(t, "test description", hpsp.GetDescription())
+	require.Equal(t, RouterKey, hpsp.ProposalRoute())
+	require.Equal(t, ProposalTypeHighPrioritySenders, hpsp.ProposalType())
+	require.NoError(t, hpsp.ValidateBasic())
+
+	for _, tt := range []struct {
+		name      string
+		namespace string
+		add       []string
+		remove    []string
+	}{
+		{"no changes", "oracles", nil, nil},
+		{"empty namespace", "", []string{addr1}, nil},
+		{"bad namespace", "oracles!", []string{addr1}, nil},
+		{"bad address", "oracles", []string{"agoric1bad"}, nil},
+		{"duplicate add", "oracles", []string{addr1, addr1}, nil},
+		{"add and remove", "oracles", []string{addr1}, []string{addr1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			hpsp := NewHighPrioritySendersProposal("test title", "test description", tt.namespace, tt.add, tt.remove)
+			require.Error(t, hpsp.ValidateBasic())
+		})
+	}
+}
+
+func TestNormalizeSenderNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		namespace string
+		want      string
+		wantErr   bool
+	}{
+		{namespace: "oracles", want: "oracles"},
+		{namespace: "ATOM-USD price feed (oracle)", want: "ATOM-USD_price_feed__oracle_"},
+		{namespace: "a,b", want: "a_b"},
+		{namespace: "", wantErr: true},
+		{namespace: "bad!", wantErr: true},
+		{namespace: strings.Repeat("x", 51), wantErr: true},
+	} {
+		got, err := NormalizeSenderNamespace(tt.namespace)
+		if tt.wantErr {
+			require.Error(t, err, tt.namespace)
+			continue
+		}
+		require.NoError(t, err, tt.namespace)
+		require.Equal(t, tt.want, got)
+	}
+}
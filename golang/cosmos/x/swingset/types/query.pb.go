@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -293,6 +294,404 @@ func (m *QueryMailboxResponse) GetValue() string {
 	return ""
 }
 
+// QueryHighPrioritySendersRequest is the request type for the
+// Query/HighPrioritySenders RPC method.
+type QueryHighPrioritySendersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHighPrioritySendersRequest) Reset()         { *m = QueryHighPrioritySendersRequest{} }
+func (m *QueryHighPrioritySendersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHighPrioritySendersRequest) ProtoMessage()    {}
+func (*QueryHighPrioritySendersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{6}
+}
+func (m *QueryHighPrioritySendersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHighPrioritySendersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHighPrioritySendersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHighPrioritySendersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHighPrioritySendersRequest.Merge(m, src)
+}
+func (m *QueryHighPrioritySendersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHighPrioritySendersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHighPrioritySendersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHighPrioritySendersRequest proto.InternalMessageInfo
+
+func (m *QueryHighPrioritySendersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHighPrioritySendersResponse is the response type for the
+// Query/HighPrioritySenders RPC method.
+type QueryHighPrioritySendersResponse struct {
+	Senders    []HighPrioritySender `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders" yaml:"senders"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHighPrioritySendersResponse) Reset()         { *m = QueryHighPrioritySendersResponse{} }
+func (m *QueryHighPrioritySendersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHighPrioritySendersResponse) ProtoMessage()    {}
+func (*QueryHighPrioritySendersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{7}
+}
+func (m *QueryHighPrioritySendersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHighPrioritySendersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHighPrioritySendersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHighPrioritySendersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHighPrioritySendersResponse.Merge(m, src)
+}
+func (m *QueryHighPrioritySendersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHighPrioritySendersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHighPrioritySendersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHighPrioritySendersResponse proto.InternalMessageInfo
+
+func (m *QueryHighPrioritySendersResponse) GetSenders() []HighPrioritySender {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *QueryHighPrioritySendersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySwingStoreExportDataRequest is the request type for the
+// Query/SwingStoreExportData RPC method.
+type QuerySwingStoreExportDataRequest struct {
+	// prefix restricts the listed entries to the keys starting with it.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix" yaml:"prefix"`
+	// keys_only omits the values of the listed entries.
+	KeysOnly   bool               `protobuf:"varint,2,opt,name=keys_only,json=keysOnly,proto3" json:"keysOnly" yaml:"keysOnly"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwingStoreExportDataRequest) Reset()         { *m = QuerySwingStoreExportDataRequest{} }
+func (m *QuerySwingStoreExportDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataRequest) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{8}
+}
+func (m *QuerySwingStoreExportDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataRequest.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataRequest proto.InternalMessageInfo
+
+func (m *QuerySwingStoreExportDataRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QuerySwingStoreExportDataRequest) GetKeysOnly() bool {
+	if m != nil {
+		return m.KeysOnly
+	}
+	return false
+}
+
+func (m *QuerySwingStoreExportDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySwingStoreExportDataResponse is the response type for the
+// Query/SwingStoreExportData RPC method.
+type QuerySwingStoreExportDataResponse struct {
+	Entries    []SwingStoreExportDataEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwingStoreExportDataResponse) Reset()         { *m = QuerySwingStoreExportDataResponse{} }
+func (m *QuerySwingStoreExportDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataResponse) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{9}
+}
+func (m *QuerySwingStoreExportDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataResponse.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataResponse proto.InternalMessageInfo
+
+func (m *QuerySwingStoreExportDataResponse) GetEntries() []SwingStoreExportDataEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QuerySwingStoreExportDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySwingStoreExportDataEntryRequest is the request type for the
+// Query/SwingStoreExportDataEntry RPC method.
+type QuerySwingStoreExportDataEntryRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key" yaml:"key"`
+}
+
+func (m *QuerySwingStoreExportDataEntryRequest) Reset()         { *m = QuerySwingStoreExportDataEntryRequest{} }
+func (m *QuerySwingStoreExportDataEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataEntryRequest) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{10}
+}
+func (m *QuerySwingStoreExportDataEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataEntryRequest.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataEntryRequest proto.InternalMessageInfo
+
+func (m *QuerySwingStoreExportDataEntryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// QuerySwingStoreExportDataEntryResponse is the response type for the
+// Query/SwingStoreExportDataEntry RPC method.
+type QuerySwingStoreExportDataEntryResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"value"`
+}
+
+func (m *QuerySwingStoreExportDataEntryResponse) Reset() {
+	*m = QuerySwingStoreExportDataEntryResponse{}
+}
+func (m *QuerySwingStoreExportDataEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataEntryResponse) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{11}
+}
+func (m *QuerySwingStoreExportDataEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataEntryResponse.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataEntryResponse proto.InternalMessageInfo
+
+func (m *QuerySwingStoreExportDataEntryResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// QuerySwingStoreExportDataStatsRequest is the request type for the
+// Query/SwingStoreExportDataStats RPC method.
+type QuerySwingStoreExportDataStatsRequest struct {
+}
+
+func (m *QuerySwingStoreExportDataStatsRequest) Reset()         { *m = QuerySwingStoreExportDataStatsRequest{} }
+func (m *QuerySwingStoreExportDataStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataStatsRequest) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{12}
+}
+func (m *QuerySwingStoreExportDataStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataStatsRequest.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataStatsRequest proto.InternalMessageInfo
+
+// QuerySwingStoreExportDataStatsResponse is the response type for the
+// Query/SwingStoreExportDataStats RPC method.
+type QuerySwingStoreExportDataStatsResponse struct {
+	Stats SwingStoreExportDataStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+}
+
+func (m *QuerySwingStoreExportDataStatsResponse) Reset() {
+	*m = QuerySwingStoreExportDataStatsResponse{}
+}
+func (m *QuerySwingStoreExportDataStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwingStoreExportDataStatsResponse) ProtoMessage()    {}
+func (*QuerySwingStoreExportDataStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{13}
+}
+func (m *QuerySwingStoreExportDataStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwingStoreExportDataStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwingStoreExportDataStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwingStoreExportDataStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwingStoreExportDataStatsResponse.Merge(m, src)
+}
+func (m *QuerySwingStoreExportDataStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwingStoreExportDataStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwingStoreExportDataStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwingStoreExportDataStatsResponse proto.InternalMessageInfo
+
+func (m *QuerySwingStoreExportDataStatsResponse) GetStats() SwingStoreExportDataStats {
+	if m != nil {
+		return m.Stats
+	}
+	return SwingStoreExportDataStats{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.swingset.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.swingset.QueryParamsResponse")
@@ -300,43 +699,81 @@ func init() {
 	proto.RegisterType((*QueryEgressResponse)(nil), "agoric.swingset.QueryEgressResponse")
 	proto.RegisterType((*QueryMailboxRequest)(nil), "agoric.swingset.QueryMailboxRequest")
 	proto.RegisterType((*QueryMailboxResponse)(nil), "agoric.swingset.QueryMailboxResponse")
+	proto.RegisterType((*QueryHighPrioritySendersRequest)(nil), "agoric.swingset.QueryHighPrioritySendersRequest")
+	proto.RegisterType((*QueryHighPrioritySendersResponse)(nil), "agoric.swingset.QueryHighPrioritySendersResponse")
+	proto.RegisterType((*QuerySwingStoreExportDataRequest)(nil), "agoric.swingset.QuerySwingStoreExportDataRequest")
+	proto.RegisterType((*QuerySwingStoreExportDataResponse)(nil), "agoric.swingset.QuerySwingStoreExportDataResponse")
+	proto.RegisterType((*QuerySwingStoreExportDataEntryRequest)(nil), "agoric.swingset.QuerySwingStoreExportDataEntryRequest")
+	proto.RegisterType((*QuerySwingStoreExportDataEntryResponse)(nil), "agoric.swingset.QuerySwingStoreExportDataEntryResponse")
+	proto.RegisterType((*QuerySwingStoreExportDataStatsRequest)(nil), "agoric.swingset.QuerySwingStoreExportDataStatsRequest")
+	proto.RegisterType((*QuerySwingStoreExportDataStatsResponse)(nil), "agoric.swingset.QuerySwingStoreExportDataStatsResponse")
 }
 
 func init() { proto.RegisterFile("agoric/swingset/query.proto", fileDescriptor_76266f656a1a9971) }
 
 var fileDescriptor_76266f656a1a9971 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0xcb, 0x6e, 0xc5, 0xec, 0x82, 0x10, 0x07, 0xd6, 0x1d, 0x25, 0x59, 0xe3, 0xcf,
-	0xcb, 0x36, 0xb0, 0xe2, 0x45, 0x4f, 0x33, 0xe0, 0x8f, 0x83, 0x82, 0x0e, 0x78, 0x11, 0x2f, 0x99,
-	0x4e, 0x88, 0xc5, 0xb6, 0xe9, 0x36, 0xa9, 0xee, 0xb0, 0x88, 0xe0, 0x5f, 0x20, 0xf8, 0x4f, 0xed,
-	0x71, 0xc1, 0x8b, 0xa7, 0x22, 0x33, 0x9e, 0xf6, 0x38, 0x47, 0xbd, 0x48, 0x93, 0x54, 0xed, 0x94,
-	0xea, 0x6d, 0x4f, 0xd3, 0xbc, 0xf7, 0xf2, 0xfd, 0xbc, 0x97, 0xf7, 0x1d, 0x70, 0x99, 0x09, 0x99,
-	0x47, 0x21, 0x55, 0xef, 0xa2, 0x54, 0x28, 0xae, 0xe9, 0x41, 0xc1, 0xf3, 0x59, 0x90, 0xe5, 0x52,
-	0x4b, 0x78, 0xc1, 0x26, 0x83, 0x3a, 0x39, 0xe8, 0x0b, 0x29, 0xa4, 0xc9, 0xd1, 0xea, 0xcb, 0x96,
-	0x0d, 0xd0, 0xaa, 0x46, 0xfd, 0xe1, 0xf2, 0x57, 0x84, 0x94, 0x22, 0xe6, 0x94, 0x65, 0x11, 0x65,
-	0x69, 0x2a, 0x35, 0xd3, 0x91, 0x4c, 0x95, 0xcd, 0x92, 0x3e, 0x80, 0xcf, 0x2b, 0xe6, 0x33, 0x96,
-	0xb3, 0x44, 0x8d, 0xf9, 0x41, 0xc1, 0x95, 0x26, 0x4f, 0xc0, 0xc5, 0x46, 0x54, 0x65, 0x32, 0x55,
-	0x1c, 0xde, 0x05, 0x7e, 0x66, 0x22, 0x97, 0xbc, 0x5d, 0xef, 0xf6, 0xe6, 0xfe, 0x76, 0xb0, 0xd2,
-	0x62, 0x60, 0x2f, 0x8c, 0xd6, 0x8f, 0x4b, 0xdc, 0x1b, 0xbb, 0x62, 0x92, 0x3b, 0xc6, 0x03, 0x91,
-	0x73, 0x55, 0x33, 0xe0, 0x2b, 0xb0, 0x9e, 0x71, 0x9e, 0x1b, 0xa9, 0xad, 0xd1, 0xe3, 0xd3, 0x12,
-	0x9b, 0xf3, 0xb2, 0xc4, 0x9b, 0x33, 0x96, 0xc4, 0xf7, 0x48, 0x75, 0x22, 0x3f, 0x4a, 0xbc, 0x27,
-	0x22, 0xfd, 0xba, 0x98, 0x04, 0xa1, 0x4c, 0x68, 0x28, 0x55, 0x22, 0x95, 0xfb, 0xd9, 0x53, 0xd3,
-	0x37, 0x54, 0xcf, 0x32, 0xae, 0x82, 0x61, 0x18, 0x0e, 0xa7, 0x53, 0x23, 0x6f, 0x54, 0xc8, 0x43,
-	0x37, 0x41, 0xcd, 0x74, 0x13, 0x50, 0xe0, 0x73, 0x13, 0xe9, 0x9c, 0xc0, 0x5d, 0x70, 0x65, 0x44,
-	0x39, 0x9d, 0xa7, 0x2c, 0x8a, 0x27, 0xf2, 0xf0, 0x6c, 0x9a, 0x7f, 0x04, 0xfa, 0x4d, 0xe8, 0xef,
-	0xee, 0x37, 0xde, 0xb2, 0xb8, 0xe0, 0x06, 0x7b, 0x7e, 0xb4, 0x73, 0x5a, 0x62, 0x1b, 0x58, 0x96,
-	0x78, 0xcb, 0x72, 0xcd, 0x91, 0x8c, 0x6d, 0x78, 0xff, 0xe7, 0x1a, 0xd8, 0x30, 0x4a, 0x50, 0x03,
-	0xdf, 0xee, 0x06, 0x5e, 0x6b, 0x8d, 0xdc, 0x36, 0xc0, 0xe0, 0xfa, 0xbf, 0x8b, 0x6c, 0x3f, 0x04,
-	0x7f, 0xfc, 0xf2, 0xfd, 0xf3, 0xda, 0x0e, 0xdc, 0xa6, 0xab, 0x1e, 0xb4, 0x9b, 0x87, 0x47, 0xc0,
-	0xb7, 0xef, 0xd9, 0x45, 0x6d, 0x58, 0xa2, 0x8b, 0xda, 0xdc, 0x21, 0xb9, 0x69, 0xa8, 0xbb, 0x10,
-	0xb5, 0xa8, 0x76, 0x67, 0xf4, 0xa8, 0x7a, 0xc4, 0xf7, 0xf0, 0x03, 0x38, 0xe7, 0x1e, 0x10, 0x76,
-	0x08, 0x37, 0x97, 0x3a, 0xb8, 0xf1, 0x9f, 0x2a, 0xc7, 0xbf, 0x65, 0xf8, 0x57, 0x21, 0x6e, 0xf1,
-	0x13, 0x5b, 0xe9, 0x1a, 0x18, 0xbd, 0x38, 0x9e, 0x23, 0xef, 0x64, 0x8e, 0xbc, 0x6f, 0x73, 0xe4,
-	0x7d, 0x5a, 0xa0, 0xde, 0xc9, 0x02, 0xf5, 0xbe, 0x2e, 0x50, 0xef, 0xe5, 0xfd, 0xbf, 0x5c, 0x31,
-	0xb4, 0x22, 0x56, 0xcb, 0xb8, 0x42, 0xc8, 0x98, 0xa5, 0xa2, 0xb6, 0xcb, 0xe1, 0x1f, 0x7d, 0x63,
-	0x97, 0x89, 0x6f, 0xfe, 0xb9, 0x77, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x53, 0xc2, 0x07, 0xc5,
-	0x3d, 0x04, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x8d, 0x93, 0x4e, 0x0a, 0x95, 0x26, 0x41, 0x6d, 0xb6, 0xd4, 0x93, 0x0c, 0x6d,
+	0x12, 0x45, 0xea, 0x6e, 0x92, 0x8a, 0x56, 0x02, 0x2e, 0xb1, 0x48, 0xdb, 0x03, 0x88, 0xb0, 0x11,
+	0x07, 0x10, 0x92, 0x19, 0xdb, 0xc3, 0x66, 0x15, 0x7b, 0x67, 0xbb, 0x33, 0x29, 0x59, 0x55, 0x08,
+	0x89, 0x1b, 0x37, 0x24, 0xfe, 0x0b, 0x2e, 0xfc, 0x13, 0x1c, 0x7a, 0x41, 0xaa, 0x84, 0x90, 0xb8,
+	0xb0, 0x42, 0x09, 0x07, 0xe4, 0xa3, 0x8f, 0x9c, 0xd0, 0xce, 0xbc, 0xb5, 0xe3, 0x1f, 0xeb, 0xc4,
+	0x11, 0xe2, 0x64, 0xcf, 0xfb, 0xf5, 0x7d, 0xdf, 0xbe, 0x99, 0x37, 0x83, 0x6e, 0x33, 0x5f, 0xc4,
+	0x41, 0xdd, 0x95, 0x5f, 0x05, 0xa1, 0x2f, 0xb9, 0x72, 0x9f, 0x1d, 0xf1, 0x38, 0x71, 0xa2, 0x58,
+	0x28, 0x81, 0x6f, 0x18, 0xa7, 0x93, 0x3b, 0xed, 0x45, 0x5f, 0xf8, 0x42, 0xfb, 0xdc, 0xec, 0x9f,
+	0x09, 0xb3, 0xef, 0x0c, 0xd6, 0xf0, 0x79, 0xc8, 0x65, 0x20, 0xc1, 0x5d, 0x1e, 0x74, 0xe7, 0x7f,
+	0xc0, 0xbf, 0x51, 0x17, 0xb2, 0x25, 0xa4, 0x5b, 0x63, 0x92, 0x1b, 0x78, 0xf7, 0xf9, 0x56, 0x8d,
+	0x2b, 0xb6, 0xe5, 0x46, 0xcc, 0x0f, 0x42, 0xa6, 0x02, 0x11, 0x42, 0xec, 0x9b, 0xbe, 0x10, 0x7e,
+	0x93, 0xbb, 0x2c, 0x0a, 0x5c, 0x16, 0x86, 0x42, 0x69, 0x27, 0x20, 0xd1, 0x45, 0x84, 0x3f, 0xce,
+	0xf2, 0xf7, 0x58, 0xcc, 0x5a, 0xd2, 0xe3, 0xcf, 0x8e, 0xb8, 0x54, 0xf4, 0x03, 0xb4, 0xd0, 0x67,
+	0x95, 0x91, 0x08, 0x25, 0xc7, 0x6f, 0xa3, 0x52, 0xa4, 0x2d, 0xb7, 0xac, 0x65, 0x6b, 0x7d, 0x7e,
+	0xfb, 0xa6, 0x33, 0xa0, 0xd6, 0x31, 0x09, 0x95, 0xab, 0x2f, 0x53, 0x32, 0xe5, 0x41, 0x30, 0x8d,
+	0x01, 0x63, 0xd7, 0x8f, 0xb9, 0xcc, 0x31, 0xf0, 0xe7, 0xe8, 0x6a, 0xc4, 0x79, 0xac, 0x4b, 0x5d,
+	0xaf, 0x3c, 0x6d, 0xa7, 0x44, 0xaf, 0x3b, 0x29, 0x99, 0x4f, 0x58, 0xab, 0xf9, 0x0e, 0xcd, 0x56,
+	0xf4, 0x9f, 0x94, 0xdc, 0xf7, 0x03, 0x75, 0x70, 0x54, 0x73, 0xea, 0xa2, 0xe5, 0x82, 0x6e, 0xf3,
+	0x73, 0x5f, 0x36, 0x0e, 0x5d, 0x95, 0x44, 0x5c, 0x3a, 0x3b, 0xf5, 0xfa, 0x4e, 0xa3, 0xa1, 0xcb,
+	0xeb, 0x2a, 0xf4, 0x31, 0x5a, 0xe8, 0xc3, 0x04, 0x05, 0x2e, 0x2a, 0x71, 0x6d, 0x29, 0x54, 0x00,
+	0x09, 0x10, 0x46, 0x25, 0xd4, 0xf9, 0x90, 0x05, 0xcd, 0x9a, 0x38, 0xfe, 0x7f, 0xc8, 0x3f, 0x41,
+	0x8b, 0xfd, 0xa0, 0x5d, 0xf6, 0x33, 0xcf, 0x59, 0xf3, 0x88, 0x6b, 0xd8, 0x6b, 0x95, 0xa5, 0x76,
+	0x4a, 0x8c, 0xa1, 0x93, 0x92, 0xeb, 0x06, 0x57, 0x2f, 0xa9, 0x67, 0xcc, 0x34, 0x40, 0x44, 0x17,
+	0x7a, 0x1a, 0xf8, 0x07, 0x7b, 0x71, 0x20, 0xe2, 0x40, 0x25, 0xfb, 0x3c, 0x6c, 0xf0, 0xb8, 0xdb,
+	0x86, 0xc7, 0x08, 0xf5, 0xb6, 0x0c, 0x7c, 0x95, 0x55, 0xc7, 0x70, 0x74, 0xb2, 0xfd, 0xe5, 0x98,
+	0xed, 0x0d, 0xfb, 0xcb, 0xd9, 0x63, 0x3e, 0x87, 0x5c, 0xef, 0x4c, 0x26, 0xfd, 0xc5, 0x42, 0xcb,
+	0xc5, 0x58, 0x20, 0xe0, 0x0b, 0x34, 0x2b, 0x8d, 0xe9, 0x96, 0xb5, 0x3c, 0xbd, 0x3e, 0xbf, 0xfd,
+	0xd6, 0xd0, 0xf7, 0x1f, 0x4e, 0xaf, 0xac, 0x64, 0xbb, 0xa9, 0x9d, 0x92, 0x3c, 0xb7, 0x93, 0x92,
+	0xd7, 0x8d, 0x5a, 0x30, 0x50, 0x2f, 0x77, 0xe1, 0x27, 0x7d, 0x72, 0xae, 0x68, 0x39, 0x6b, 0xe7,
+	0xca, 0x31, 0xf4, 0xfa, 0xf4, 0xfc, 0x91, 0xeb, 0xd9, 0xcf, 0x98, 0xed, 0x2b, 0x11, 0xf3, 0xdd,
+	0xe3, 0x48, 0xc4, 0xea, 0x7d, 0xa6, 0x58, 0xfe, 0xf1, 0x1e, 0xa0, 0x52, 0x14, 0xf3, 0x2f, 0x83,
+	0x63, 0xe8, 0xc8, 0xed, 0x76, 0x4a, 0xc0, 0xd2, 0x49, 0xc9, 0x6b, 0xb0, 0x15, 0xf4, 0x9a, 0x7a,
+	0xe0, 0xc0, 0xef, 0xa1, 0x6b, 0x87, 0x3c, 0x91, 0x55, 0x11, 0x36, 0x13, 0xcd, 0x70, 0xae, 0x42,
+	0xda, 0x29, 0x99, 0xcb, 0x8c, 0x1f, 0x85, 0xcd, 0xa4, 0x93, 0x92, 0x1b, 0x26, 0x33, 0xb7, 0x50,
+	0xaf, 0xeb, 0x1c, 0xe8, 0xd7, 0xf4, 0xa5, 0xfb, 0xf5, 0x9b, 0x85, 0x56, 0xc6, 0xe8, 0x83, 0x86,
+	0xf9, 0x68, 0x96, 0x87, 0x2a, 0x0e, 0x78, 0xde, 0xb0, 0x8d, 0xa1, 0x86, 0x8d, 0xca, 0xdf, 0x0d,
+	0x55, 0x9c, 0xf4, 0xfa, 0x06, 0x25, 0x7a, 0x7d, 0x03, 0x03, 0xf5, 0x72, 0xd7, 0x7f, 0xd7, 0xb7,
+	0x3d, 0x74, 0xaf, 0x50, 0x96, 0xa6, 0x95, 0xf7, 0x6e, 0x0d, 0x4d, 0x1f, 0xf2, 0x04, 0x1a, 0xf7,
+	0x46, 0x3b, 0x25, 0xd9, 0xb2, 0x93, 0x12, 0xd4, 0xfd, 0xf6, 0xd4, 0xcb, 0x4c, 0xf4, 0x53, 0xb4,
+	0x7a, 0x5e, 0xc5, 0xcb, 0x9e, 0xcf, 0xb5, 0x31, 0x64, 0xf7, 0x15, 0x53, 0xdd, 0x81, 0xfc, 0x9d,
+	0x85, 0x56, 0xcf, 0x8b, 0x04, 0x12, 0x55, 0x34, 0x23, 0x33, 0x03, 0x9c, 0xe5, 0x8b, 0x35, 0x4c,
+	0x97, 0xa8, 0xdc, 0x81, 0x86, 0x99, 0x02, 0x3d, 0xd2, 0x7a, 0x49, 0x3d, 0x63, 0xde, 0xfe, 0x7b,
+	0x0e, 0xcd, 0x68, 0x2e, 0x58, 0xa1, 0x92, 0x19, 0xf8, 0x78, 0xf8, 0x1c, 0x0f, 0xdf, 0x2a, 0xf6,
+	0xdd, 0xf1, 0x41, 0x86, 0x3f, 0x25, 0xdf, 0xfe, 0xfa, 0xd7, 0x0f, 0x57, 0x96, 0xf0, 0x4d, 0x77,
+	0xf0, 0x12, 0x34, 0xd7, 0x09, 0x7e, 0x81, 0x4a, 0x66, 0x48, 0x17, 0xa1, 0xf6, 0xdd, 0x33, 0xf6,
+	0xdd, 0xf1, 0x41, 0x80, 0xba, 0xaa, 0x51, 0x97, 0x71, 0x79, 0x08, 0xd5, 0x5c, 0x04, 0xee, 0x8b,
+	0x6c, 0x32, 0x7f, 0x8d, 0xbf, 0x41, 0xb3, 0x30, 0x95, 0x71, 0x41, 0xe1, 0xfe, 0x9b, 0xc2, 0xbe,
+	0x77, 0x4e, 0x14, 0xe0, 0xaf, 0x69, 0xfc, 0x15, 0x4c, 0x86, 0xf0, 0x5b, 0x26, 0x32, 0x27, 0xf0,
+	0xa3, 0x85, 0x16, 0x46, 0x8c, 0x58, 0xbc, 0x39, 0x1a, 0xa7, 0x78, 0xf2, 0xdb, 0x5b, 0x13, 0x64,
+	0x00, 0x4b, 0x47, 0xb3, 0x5c, 0xc7, 0xab, 0x43, 0x2c, 0x0f, 0x02, 0xff, 0xa0, 0x1a, 0x41, 0x5a,
+	0x35, 0x9f, 0xc6, 0x3f, 0x59, 0x68, 0x71, 0xd4, 0x76, 0xc3, 0x05, 0xd8, 0x63, 0x66, 0xad, 0xbd,
+	0x3d, 0x49, 0x0a, 0xf0, 0xdd, 0xd4, 0x7c, 0x37, 0xf0, 0xba, 0x3b, 0xf2, 0x41, 0x55, 0x95, 0x59,
+	0x5e, 0x95, 0xeb, 0xc4, 0x6a, 0x23, 0x23, 0xf6, 0xb3, 0x85, 0x96, 0x0a, 0x0f, 0x3a, 0x7e, 0x78,
+	0x71, 0x0e, 0x67, 0x67, 0x8d, 0xfd, 0x68, 0xe2, 0x3c, 0x10, 0xf0, 0x50, 0x0b, 0xd8, 0xc4, 0xce,
+	0x45, 0x05, 0xb8, 0x5c, 0x13, 0x2d, 0x92, 0xa1, 0xcf, 0xf9, 0x24, 0x32, 0xce, 0x4e, 0x21, 0xfb,
+	0xd1, 0xc4, 0x79, 0x97, 0x96, 0xa1, 0x47, 0x4d, 0xe5, 0x93, 0x97, 0x27, 0x65, 0xeb, 0xd5, 0x49,
+	0xd9, 0xfa, 0xf3, 0xa4, 0x6c, 0x7d, 0x7f, 0x5a, 0x9e, 0x7a, 0x75, 0x5a, 0x9e, 0xfa, 0xfd, 0xb4,
+	0x3c, 0xf5, 0xd9, 0xbb, 0x67, 0xde, 0x55, 0x3b, 0xa6, 0xa6, 0x29, 0xad, 0xdf, 0x55, 0xbe, 0x68,
+	0xb2, 0xd0, 0xcf, 0x1f, 0x5c, 0xc7, 0x3d, 0x38, 0xfd, 0xe0, 0xaa, 0x95, 0xf4, 0xdb, 0xf7, 0xc1,
+	0xbf, 0x03, 0x00, 0x19, 0xb0, 0x59, 0xdb, 0xca, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -357,6 +794,18 @@ type QueryClient interface {
 	Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error)
 	// Return the contents of a peer's outbound mailbox.
 	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+	// HighPrioritySenders lists the addresses which bypass the inbound queue
+	// limits, along with the namespaces which granted them priority.
+	HighPrioritySenders(ctx context.Context, in *QueryHighPrioritySendersRequest, opts ...grpc.CallOption) (*QueryHighPrioritySendersResponse, error)
+	// SwingStoreExportData lists the swing-store "export data" entries whose key
+	// starts with a prefix, such as "kv.", "transcript.v1." or "bundle.".
+	SwingStoreExportData(ctx context.Context, in *QuerySwingStoreExportDataRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataResponse, error)
+	// SwingStoreExportDataEntry returns the value of a swing-store "export data"
+	// entry.
+	SwingStoreExportDataEntry(ctx context.Context, in *QuerySwingStoreExportDataEntryRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataEntryResponse, error)
+	// SwingStoreExportDataStats returns the number of entries and size of the
+	// swing-store "export data" by kind of entry.
+	SwingStoreExportDataStats(ctx context.Context, in *QuerySwingStoreExportDataStatsRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataStatsResponse, error)
 }
 
 type queryClient struct {
@@ -394,6 +843,42 @@ func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) HighPrioritySenders(ctx context.Context, in *QueryHighPrioritySendersRequest, opts ...grpc.CallOption) (*QueryHighPrioritySendersResponse, error) {
+	out := new(QueryHighPrioritySendersResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/HighPrioritySenders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwingStoreExportData(ctx context.Context, in *QuerySwingStoreExportDataRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataResponse, error) {
+	out := new(QuerySwingStoreExportDataResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/SwingStoreExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwingStoreExportDataEntry(ctx context.Context, in *QuerySwingStoreExportDataEntryRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataEntryResponse, error) {
+	out := new(QuerySwingStoreExportDataEntryResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/SwingStoreExportDataEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwingStoreExportDataStats(ctx context.Context, in *QuerySwingStoreExportDataStatsRequest, opts ...grpc.CallOption) (*QuerySwingStoreExportDataStatsResponse, error) {
+	out := new(QuerySwingStoreExportDataStatsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/SwingStoreExportDataStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the swingset module.
@@ -402,6 +887,18 @@ type QueryServer interface {
 	Egress(context.Context, *QueryEgressRequest) (*QueryEgressResponse, error)
 	// Return the contents of a peer's outbound mailbox.
 	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+	// HighPrioritySenders lists the addresses which bypass the inbound queue
+	// limits, along with the namespaces which granted them priority.
+	HighPrioritySenders(context.Context, *QueryHighPrioritySendersRequest) (*QueryHighPrioritySendersResponse, error)
+	// SwingStoreExportData lists the swing-store "export data" entries whose key
+	// starts with a prefix, such as "kv.", "transcript.v1." or "bundle.".
+	SwingStoreExportData(context.Context, *QuerySwingStoreExportDataRequest) (*QuerySwingStoreExportDataResponse, error)
+	// SwingStoreExportDataEntry returns the value of a swing-store "export data"
+	// entry.
+	SwingStoreExportDataEntry(context.Context, *QuerySwingStoreExportDataEntryRequest) (*QuerySwingStoreExportDataEntryResponse, error)
+	// SwingStoreExportDataStats returns the number of entries and size of the
+	// swing-store "export data" by kind of entry.
+	SwingStoreExportDataStats(context.Context, *QuerySwingStoreExportDataStatsRequest) (*QuerySwingStoreExportDataStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -417,6 +914,18 @@ func (*UnimplementedQueryServer) Egress(ctx context.Context, req *QueryEgressReq
 func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
 }
+func (*UnimplementedQueryServer) HighPrioritySenders(ctx context.Context, req *QueryHighPrioritySendersRequest) (*QueryHighPrioritySendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighPrioritySenders not implemented")
+}
+func (*UnimplementedQueryServer) SwingStoreExportData(ctx context.Context, req *QuerySwingStoreExportDataRequest) (*QuerySwingStoreExportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwingStoreExportData not implemented")
+}
+func (*UnimplementedQueryServer) SwingStoreExportDataEntry(ctx context.Context, req *QuerySwingStoreExportDataEntryRequest) (*QuerySwingStoreExportDataEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwingStoreExportDataEntry not implemented")
+}
+func (*UnimplementedQueryServer) SwingStoreExportDataStats(ctx context.Context, req *QuerySwingStoreExportDataStatsRequest) (*QuerySwingStoreExportDataStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwingStoreExportDataStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -476,9 +985,81 @@ func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Query",
-	HandlerType: (*QueryServer)(nil),
+func _Query_HighPrioritySenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHighPrioritySendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HighPrioritySenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/HighPrioritySenders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HighPrioritySenders(ctx, req.(*QueryHighPrioritySendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwingStoreExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwingStoreExportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwingStoreExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/SwingStoreExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwingStoreExportData(ctx, req.(*QuerySwingStoreExportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwingStoreExportDataEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwingStoreExportDataEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwingStoreExportDataEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/SwingStoreExportDataEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwingStoreExportDataEntry(ctx, req.(*QuerySwingStoreExportDataEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwingStoreExportDataStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwingStoreExportDataStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwingStoreExportDataStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/SwingStoreExportDataStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwingStoreExportDataStats(ctx, req.(*QuerySwingStoreExportDataStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Query",
+	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Params",
@@ -492,6 +1073,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Mailbox",
 			Handler:    _Query_Mailbox_Handler,
 		},
+		{
+			MethodName: "HighPrioritySenders",
+			Handler:    _Query_HighPrioritySenders_Handler,
+		},
+		{
+			MethodName: "SwingStoreExportData",
+			Handler:    _Query_SwingStoreExportData_Handler,
+		},
+		{
+			MethodName: "SwingStoreExportDataEntry",
+			Handler:    _Query_SwingStoreExportDataEntry_Handler,
+		},
+		{
+			MethodName: "SwingStoreExportDataStats",
+			Handler:    _Query_SwingStoreExportDataStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agoric/swingset/query.proto",
@@ -678,124 +1275,1167 @@ func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHighPrioritySendersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryHighPrioritySendersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHighPrioritySendersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHighPrioritySendersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHighPrioritySendersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHighPrioritySendersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Senders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySwingStoreExportDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwingStoreExportDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwingStoreExportDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.KeysOnly {
+		i--
+		if m.KeysOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMailboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySwingStoreExportDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwingStoreExportDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwingStoreExportDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMailboxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySwingStoreExportDataEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwingStoreExportDataEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwingStoreExportDataEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySwingStoreExportDataEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySwingStoreExportDataEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QuerySwingStoreExportDataEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwingStoreExportDataStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwingStoreExportDataStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwingStoreExportDataStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwingStoreExportDataStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwingStoreExportDataStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwingStoreExportDataStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHighPrioritySendersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHighPrioritySendersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, e := range m.Senders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwingStoreExportDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeysOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwingStoreExportDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwingStoreExportDataEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwingStoreExportDataEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwingStoreExportDataStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySwingStoreExportDataStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHighPrioritySendersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHighPrioritySendersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Senders = append(m.Senders, HighPrioritySender{})
+			if err := m.Senders[len(m.Senders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -817,7 +2457,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwingStoreExportDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,15 +2480,67 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwingStoreExportDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwingStoreExportDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeysOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -875,7 +2567,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -900,7 +2595,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwingStoreExportDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -923,17 +2618,17 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEgressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwingStoreExportDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwingStoreExportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -943,24 +2638,60 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
+			m.Entries = append(m.Entries, SwingStoreExportDataEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -984,7 +2715,7 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwingStoreExportDataEntryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,17 +2738,17 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEgressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwingStoreExportDataEntryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwingStoreExportDataEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1027,27 +2758,23 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1070,7 +2797,7 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwingStoreExportDataEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,17 +2820,17 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwingStoreExportDataEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwingStoreExportDataEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1113,25 +2840,23 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1154,7 +2879,7 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwingStoreExportDataStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,17 +2902,67 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwingStoreExportDataStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwingStoreExportDataStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwingStoreExportDataStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwingStoreExportDataStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwingStoreExportDataStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1197,23 +2972,24 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
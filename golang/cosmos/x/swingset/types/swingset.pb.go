@@ -127,6 +127,183 @@ func (m *CoreEval) GetJsCode() string {
 	return ""
 }
 
+// HighPrioritySendersProposal is a gov Content type for adding or removing
+// high priority senders under a namespace label (e.g. "oracle").  High
+// priority senders bypass the inbound queue limits for their spend actions.
+type HighPrioritySendersProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The label under which the senders are added or removed.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Bech32 addresses to add to the namespace.
+	Add []string `protobuf:"bytes,4,rep,name=add,proto3" json:"add,omitempty"`
+	// Bech32 addresses to remove from the namespace.
+	Remove []string `protobuf:"bytes,5,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *HighPrioritySendersProposal) Reset()         { *m = HighPrioritySendersProposal{} }
+func (m *HighPrioritySendersProposal) String() string { return proto.CompactTextString(m) }
+func (*HighPrioritySendersProposal) ProtoMessage()    {}
+func (*HighPrioritySendersProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{2}
+}
+func (m *HighPrioritySendersProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HighPrioritySendersProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HighPrioritySendersProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HighPrioritySendersProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighPrioritySendersProposal.Merge(m, src)
+}
+func (m *HighPrioritySendersProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HighPrioritySendersProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighPrioritySendersProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighPrioritySendersProposal proto.InternalMessageInfo
+
+// HighPrioritySender describes an address which has been granted high
+// priority by one or more namespaces.
+type HighPrioritySender struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+	// The sorted namespaces which have requested priority for the address.
+	Namespaces []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces" yaml:"namespaces"`
+}
+
+func (m *HighPrioritySender) Reset()         { *m = HighPrioritySender{} }
+func (m *HighPrioritySender) String() string { return proto.CompactTextString(m) }
+func (*HighPrioritySender) ProtoMessage()    {}
+func (*HighPrioritySender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{3}
+}
+func (m *HighPrioritySender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HighPrioritySender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HighPrioritySender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HighPrioritySender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighPrioritySender.Merge(m, src)
+}
+func (m *HighPrioritySender) XXX_Size() int {
+	return m.Size()
+}
+func (m *HighPrioritySender) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighPrioritySender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighPrioritySender proto.InternalMessageInfo
+
+func (m *HighPrioritySender) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HighPrioritySender) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// EventHighPrioritySenderChanged is emitted when a namespace is added to or
+// removed from the namespaces of a high priority sender.
+type EventHighPrioritySenderChanged struct {
+	// The bech32 address of the sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The namespace that was added or removed.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// True if the namespace was added, false if it was removed.
+	Added bool `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty"`
+	// The resulting namespaces of the sender.  If empty, the sender no longer
+	// has high priority.
+	Namespaces []string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (m *EventHighPrioritySenderChanged) Reset()         { *m = EventHighPrioritySenderChanged{} }
+func (m *EventHighPrioritySenderChanged) String() string { return proto.CompactTextString(m) }
+func (*EventHighPrioritySenderChanged) ProtoMessage()    {}
+func (*EventHighPrioritySenderChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{4}
+}
+func (m *EventHighPrioritySenderChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHighPrioritySenderChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHighPrioritySenderChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHighPrioritySenderChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHighPrioritySenderChanged.Merge(m, src)
+}
+func (m *EventHighPrioritySenderChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHighPrioritySenderChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHighPrioritySenderChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHighPrioritySenderChanged proto.InternalMessageInfo
+
+func (m *EventHighPrioritySenderChanged) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventHighPrioritySenderChanged) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *EventHighPrioritySenderChanged) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+func (m *EventHighPrioritySenderChanged) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 // Params are the swingset configuration/governance parameters.
 type Params struct {
 	// Map from unit name to a value in SwingSet "beans".
@@ -161,12 +338,26 @@ type Params struct {
 	// nodes must all serialize and deserialize the existing order without
 	// permuting it.
 	QueueMax []QueueSize `protobuf:"bytes,5,rep,name=queue_max,json=queueMax,proto3" json:"queue_max"`
+	// The maximum number of inbound queue items that a single transaction may
+	// add (exempting high priority senders).  Must be positive.
+	MaxInboundPerTx int32 `protobuf:"varint,6,opt,name=max_inbound_per_tx,json=maxInboundPerTx,proto3" json:"max_inbound_per_tx,omitempty"`
+	// The fraction of the inbound queue max size used to compute the number of
+	// messages allowed for mempool admission (CheckTx).  Keeping it below 1
+	// provides hysteresis, since at CheckTx time we don't know how many
+	// messages will be allowed at DeliverTx time.  Must be in (0, 1].
+	InboundMempoolQueueRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inbound_mempool_queue_ratio,json=inboundMempoolQueueRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inbound_mempool_queue_ratio"`
+	// The number of bytes (keys and values) of swing-store "export data"
+	// expected to be written at most in a single block.  Since the JS
+	// swing-store has already committed the updates, those exceeding it are
+	// still applied, but reported by an EventSwingStoreExportDataAlarm.  Zero
+	// means unlimited.
+	ExportDataBlockBudget uint64 `protobuf:"varint,8,opt,name=export_data_block_budget,json=exportDataBlockBudget,proto3" json:"export_data_block_budget,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{2}
+	return fileDescriptor_ff9c341e0de15f8b, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,6 +421,20 @@ func (m *Params) GetQueueMax() []QueueSize {
 	return nil
 }
 
+func (m *Params) GetMaxInboundPerTx() int32 {
+	if m != nil {
+		return m.MaxInboundPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetExportDataBlockBudget() uint64 {
+	if m != nil {
+		return m.ExportDataBlockBudget
+	}
+	return 0
+}
+
 // The current state of the module.
 type State struct {
 	// The allowed number of items to add to queues, as determined by SwingSet.
@@ -241,7 +446,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{3}
+	return fileDescriptor_ff9c341e0de15f8b, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +494,7 @@ func (m *StringBeans) Reset()         { *m = StringBeans{} }
 func (m *StringBeans) String() string { return proto.CompactTextString(m) }
 func (*StringBeans) ProtoMessage()    {}
 func (*StringBeans) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{4}
+	return fileDescriptor_ff9c341e0de15f8b, []int{7}
 }
 func (m *StringBeans) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +540,7 @@ func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
 func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
 func (*PowerFlagFee) ProtoMessage()    {}
 func (*PowerFlagFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{5}
+	return fileDescriptor_ff9c341e0de15f8b, []int{8}
 }
 func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +595,7 @@ func (m *QueueSize) Reset()         { *m = QueueSize{} }
 func (m *QueueSize) String() string { return proto.CompactTextString(m) }
 func (*QueueSize) ProtoMessage()    {}
 func (*QueueSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{6}
+	return fileDescriptor_ff9c341e0de15f8b, []int{9}
 }
 func (m *QueueSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +650,7 @@ func (m *Egress) Reset()         { *m = Egress{} }
 func (m *Egress) String() string { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()    {}
 func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{7}
+	return fileDescriptor_ff9c341e0de15f8b, []int{10}
 }
 func (m *Egress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +713,7 @@ func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
 func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreArtifact) ProtoMessage()    {}
 func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{8}
+	return fileDescriptor_ff9c341e0de15f8b, []int{11}
 }
 func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,135 +756,512 @@ func (m *SwingStoreArtifact) GetData() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
-	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
-	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
-	proto.RegisterType((*State)(nil), "agoric.swingset.State")
-	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
-	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
-	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
-	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
-	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+// SwingStoreArtifactChunk encodes a chunk of an artifact of a swing-store
+// export. An artifact is split into one or more chunks of bounded size, which
+// are transmitted in order.
+type SwingStoreArtifactChunk struct {
+	// The name of the artifact this chunk belongs to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	// The offset of this chunk in the uncompressed artifact data. The first
+	// chunk of an artifact has offset 0.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset" yaml:"offset"`
+	// The zstd compressed data of this chunk.
+	CompressedData []byte `protobuf:"bytes,3,opt,name=compressed_data,json=compressedData,proto3" json:"compressedData" yaml:"compressedData"`
+	// The size of the uncompressed artifact data. Only set in the first chunk.
+	ArtifactSize uint64 `protobuf:"varint,4,opt,name=artifact_size,json=artifactSize,proto3" json:"artifactSize" yaml:"artifactSize"`
+	// The sha256 hash of the uncompressed artifact data. Only set in the first
+	// chunk.
+	Sha256 []byte `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256" yaml:"sha256"`
 }
 
-func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
-
-var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x49, 0x52, 0x9a, 0x97, 0x6c, 0xbb, 0x0c, 0x95, 0x36, 0x54, 0x6c, 0xa6, 0xf2, 0x85,
-	0x4a, 0xab, 0x8d, 0xb7, 0x20, 0x84, 0x94, 0x15, 0x87, 0xb8, 0xea, 0x6a, 0x25, 0x04, 0x0a, 0x8e,
-	0xca, 0x01, 0x81, 0xac, 0x89, 0x33, 0x31, 0xd3, 0x3a, 0x1e, 0xef, 0xcc, 0xf4, 0xd7, 0xfe, 0x03,
-	0x70, 0x41, 0x42, 0x9c, 0x38, 0xf6, 0xcc, 0x5f, 0xb2, 0xc7, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x17,
-	0xd4, 0x63, 0x8e, 0x48, 0x48, 0x68, 0x66, 0x1c, 0xc7, 0xa2, 0x48, 0xf4, 0xc2, 0x29, 0xf3, 0x7e,
-	0x7d, 0xef, 0x7d, 0xdf, 0x1b, 0x4f, 0xa0, 0x47, 0x62, 0x2e, 0x58, 0xe4, 0xc9, 0x33, 0x96, 0xc6,
-	0x92, 0xaa, 0xf2, 0xd0, 0xcf, 0x04, 0x57, 0x1c, 0x6d, 0xda, 0x78, 0x7f, 0xe9, 0xde, 0xde, 0x8a,
-	0x79, 0xcc, 0x4d, 0xcc, 0xd3, 0x27, 0x9b, 0xb6, 0xdd, 0x8b, 0xb8, 0x9c, 0x73, 0xe9, 0x4d, 0x88,
-	0xa4, 0xde, 0xe9, 0xde, 0x84, 0x2a, 0xb2, 0xe7, 0x45, 0x9c, 0xa5, 0x36, 0xee, 0x7e, 0xeb, 0xc0,
-	0xfd, 0x7d, 0x2e, 0xe8, 0xc1, 0x29, 0x49, 0x46, 0x82, 0x67, 0x5c, 0x92, 0x04, 0x6d, 0x41, 0x53,
-	0x31, 0x95, 0xd0, 0xae, 0xb3, 0xe3, 0xec, 0xb6, 0x02, 0x6b, 0xa0, 0x1d, 0x68, 0x4f, 0xa9, 0x8c,
-	0x04, 0xcb, 0x14, 0xe3, 0x69, 0xf7, 0x0d, 0x13, 0xab, 0xba, 0xd0, 0x87, 0xd0, 0xa4, 0xa7, 0x24,
-	0x91, 0xdd, 0xfa, 0x4e, 0x7d, 0xb7, 0xfd, 0xfe, 0x3b, 0xfd, 0x7f, 0xcc, 0xd8, 0x5f, 0x76, 0xf2,
-	0x1b, 0xaf, 0x72, 0x5c, 0x0b, 0x6c, 0xf6, 0xa0, 0xf1, 0xdd, 0x25, 0xae, 0xb9, 0x12, 0xd6, 0x97,
-	0x61, 0x34, 0x80, 0xce, 0x91, 0xe4, 0x69, 0x98, 0x51, 0x31, 0x67, 0x4a, 0xda, 0x39, 0xfc, 0x07,
-	0x8b, 0x1c, 0xbf, 0x7d, 0x41, 0xe6, 0xc9, 0xc0, 0xad, 0x46, 0xdd, 0xa0, 0xad, 0xcd, 0x91, 0xb5,
-	0xd0, 0x23, 0x78, 0xf3, 0x48, 0x86, 0x11, 0x9f, 0x52, 0x3b, 0xa2, 0x8f, 0x16, 0x39, 0xde, 0x58,
-	0x96, 0x99, 0x80, 0x1b, 0xac, 0x1d, 0xc9, 0x7d, 0x7d, 0xf8, 0xbe, 0x0e, 0x6b, 0x23, 0x22, 0xc8,
-	0x5c, 0xa2, 0xe7, 0xb0, 0x31, 0xa1, 0x24, 0x95, 0x1a, 0x36, 0x3c, 0x49, 0x99, 0xea, 0x3a, 0x86,
-	0xc5, 0xbb, 0xb7, 0x58, 0x8c, 0x95, 0x60, 0x69, 0xec, 0xeb, 0xe4, 0x82, 0x48, 0xc7, 0x54, 0x8e,
-	0xa8, 0x38, 0x4c, 0x99, 0x42, 0x2f, 0x60, 0x63, 0x46, 0xa9, 0xc1, 0x08, 0x33, 0xc1, 0x22, 0x3d,
-	0x88, 0xd5, 0xc3, 0x2e, 0xa3, 0xaf, 0x97, 0xd1, 0x2f, 0x96, 0xd1, 0xdf, 0xe7, 0x2c, 0xf5, 0x9f,
-	0x68, 0x98, 0x9f, 0x7f, 0xc3, 0xbb, 0x31, 0x53, 0xdf, 0x9c, 0x4c, 0xfa, 0x11, 0x9f, 0x7b, 0xc5,
-	0xe6, 0xec, 0xcf, 0x63, 0x39, 0x3d, 0xf6, 0xd4, 0x45, 0x46, 0xa5, 0x29, 0x90, 0x41, 0x67, 0x46,
-	0xa9, 0xee, 0x36, 0xd2, 0x0d, 0xd0, 0x13, 0xd8, 0x9a, 0x70, 0xae, 0xa4, 0x12, 0x24, 0x0b, 0x4f,
-	0x89, 0x0a, 0x23, 0x9e, 0xce, 0x58, 0xdc, 0xad, 0x9b, 0x25, 0xa1, 0x32, 0xf6, 0x05, 0x51, 0xfb,
-	0x26, 0x82, 0x3e, 0x81, 0xcd, 0x8c, 0x9f, 0x51, 0x11, 0xce, 0x12, 0x12, 0x87, 0x33, 0x4a, 0x65,
-	0xb7, 0x61, 0xa6, 0x7c, 0x78, 0x8b, 0xef, 0x48, 0xe7, 0x3d, 0x4b, 0x48, 0xfc, 0x8c, 0xd2, 0x82,
-	0xf0, 0xbd, 0xac, 0xe2, 0x93, 0xe8, 0x63, 0x68, 0xbd, 0x38, 0xa1, 0x27, 0x34, 0x9c, 0x93, 0xf3,
-	0x6e, 0xd3, 0xc0, 0x6c, 0xdf, 0x82, 0xf9, 0x5c, 0x67, 0x8c, 0xd9, 0xcb, 0x25, 0xc6, 0xba, 0x29,
-	0xf9, 0x94, 0x9c, 0x0f, 0xd6, 0x7f, 0xba, 0xc4, 0xb5, 0x3f, 0x2e, 0xb1, 0xe3, 0x7e, 0x06, 0xcd,
-	0xb1, 0x22, 0x8a, 0xa2, 0x03, 0xb8, 0x67, 0x11, 0x49, 0x92, 0xf0, 0x33, 0x3a, 0x2d, 0x96, 0xf1,
-	0xdf, 0xa8, 0x1d, 0x53, 0x36, 0xb4, 0x55, 0x6e, 0x02, 0xed, 0xca, 0xb6, 0xd0, 0x7d, 0xa8, 0x1f,
-	0xd3, 0x8b, 0xe2, 0x5a, 0xeb, 0x23, 0x3a, 0x80, 0xa6, 0xd9, 0x5d, 0x71, 0x57, 0x3c, 0x8d, 0xf1,
-	0x6b, 0x8e, 0xdf, 0xbb, 0xc3, 0x1e, 0x0e, 0x59, 0xaa, 0x02, 0x5b, 0x3d, 0x68, 0x98, 0xe9, 0x7f,
-	0x74, 0xa0, 0x53, 0x15, 0x0b, 0x3d, 0x04, 0x58, 0x89, 0x5c, 0xb4, 0x6d, 0x95, 0xd2, 0xa1, 0xaf,
-	0xa1, 0x3e, 0xa3, 0xff, 0xcb, 0xed, 0xd0, 0xb8, 0xc5, 0x50, 0x1f, 0x41, 0xab, 0xd4, 0xe8, 0x5f,
-	0x04, 0x40, 0xd0, 0x90, 0xec, 0xa5, 0xfd, 0x56, 0x9a, 0x81, 0x39, 0x17, 0x85, 0x7f, 0x39, 0xb0,
-	0x76, 0x10, 0x0b, 0x2a, 0x25, 0x7a, 0x0a, 0xeb, 0x29, 0x8b, 0x8e, 0x53, 0x32, 0x2f, 0xde, 0x04,
-	0x1f, 0xdf, 0xe4, 0xb8, 0xf4, 0x2d, 0x72, 0xbc, 0x69, 0x3f, 0xb0, 0xa5, 0xc7, 0x0d, 0xca, 0x20,
-	0xfa, 0x0a, 0x1a, 0x19, 0xa5, 0xc2, 0x74, 0xe8, 0xf8, 0xcf, 0x6f, 0x72, 0x6c, 0xec, 0x45, 0x8e,
-	0xdb, 0xb6, 0x48, 0x5b, 0xee, 0x9f, 0x39, 0x7e, 0x7c, 0x07, 0x7a, 0xc3, 0x28, 0x1a, 0x4e, 0xa7,
-	0x7a, 0xa8, 0xc0, 0xa0, 0xa0, 0x00, 0xda, 0x2b, 0x89, 0xed, 0xcb, 0xd3, 0xf2, 0xf7, 0xae, 0x72,
-	0x0c, 0xe5, 0x26, 0xe4, 0x4d, 0x8e, 0xa1, 0x54, 0x5d, 0x2e, 0x72, 0xfc, 0x56, 0xd1, 0xb8, 0xf4,
-	0xb9, 0x41, 0x25, 0xc1, 0xf0, 0xaf, 0xb9, 0x0a, 0xd0, 0x58, 0xdf, 0xb2, 0xb1, 0xe2, 0x82, 0x0e,
-	0x85, 0x62, 0x33, 0x12, 0x29, 0xf4, 0x08, 0x1a, 0x15, 0x19, 0x1e, 0x68, 0x36, 0x85, 0x04, 0x05,
-	0x1b, 0x4b, 0xdf, 0x38, 0x75, 0xf2, 0x94, 0x28, 0x52, 0x50, 0x37, 0xc9, 0xda, 0x5e, 0x25, 0x6b,
-	0xcb, 0x0d, 0x8c, 0xd3, 0x76, 0xf5, 0x0f, 0x5f, 0x5d, 0xf5, 0x9c, 0xd7, 0x57, 0x3d, 0xe7, 0xf7,
-	0xab, 0x9e, 0xf3, 0xc3, 0x75, 0xaf, 0xf6, 0xfa, 0xba, 0x57, 0xfb, 0xe5, 0xba, 0x57, 0xfb, 0xf2,
-	0x69, 0x45, 0x9e, 0xa1, 0xfd, 0x73, 0xb0, 0x1f, 0x83, 0x91, 0x27, 0xe6, 0x09, 0x49, 0xe3, 0xa5,
-	0x6e, 0xe7, 0xab, 0xff, 0x0d, 0xa3, 0xdb, 0x64, 0xcd, 0x3c, 0xf7, 0x1f, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x34, 0x5f, 0xf6, 0x57, 0x06, 0x00, 0x00,
+func (m *SwingStoreArtifactChunk) Reset()         { *m = SwingStoreArtifactChunk{} }
+func (m *SwingStoreArtifactChunk) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreArtifactChunk) ProtoMessage()    {}
+func (*SwingStoreArtifactChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SwingStoreArtifactChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreArtifactChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreArtifactChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *SwingStoreArtifactChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreArtifactChunk.Merge(m, src)
+}
+func (m *SwingStoreArtifactChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreArtifactChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreArtifactChunk.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_SwingStoreArtifactChunk proto.InternalMessageInfo
+
+func (m *SwingStoreArtifactChunk) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *SwingStoreArtifactChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
-		return false
+	return 0
+}
+
+func (m *SwingStoreArtifactChunk) GetCompressedData() []byte {
+	if m != nil {
+		return m.CompressedData
 	}
-	for i := range this.BeansPerUnit {
-		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *SwingStoreArtifactChunk) GetArtifactSize() uint64 {
+	if m != nil {
+		return m.ArtifactSize
 	}
-	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
-		return false
+	return 0
+}
+
+func (m *SwingStoreArtifactChunk) GetSha256() []byte {
+	if m != nil {
+		return m.Sha256
 	}
-	for i := range this.FeeUnitPrice {
-		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
-			return false
+	return nil
+}
+
+// The size of the swing-store "export data" entries of one kind.
+type SwingStoreExportDataSize struct {
+	// The kind of the entries, which is the first segment of their key
+	// ("kv", "transcript", "snapshot" or "bundle").
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	// The number of entries.
+	Entries uint64 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries" yaml:"entries"`
+	// The total size in bytes of the keys and values of the entries.
+	Bytes uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes" yaml:"bytes"`
+}
+
+func (m *SwingStoreExportDataSize) Reset()         { *m = SwingStoreExportDataSize{} }
+func (m *SwingStoreExportDataSize) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportDataSize) ProtoMessage()    {}
+func (*SwingStoreExportDataSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{13}
+}
+func (m *SwingStoreExportDataSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportDataSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportDataSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
-		return false
-	}
-	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
-		return false
+}
+func (m *SwingStoreExportDataSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportDataSize.Merge(m, src)
+}
+func (m *SwingStoreExportDataSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportDataSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportDataSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportDataSize proto.InternalMessageInfo
+
+func (m *SwingStoreExportDataSize) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	for i := range this.PowerFlagFees {
-		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *SwingStoreExportDataSize) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
 	}
-	if len(this.QueueMax) != len(that1.QueueMax) {
-		return false
+	return 0
+}
+
+func (m *SwingStoreExportDataSize) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
 	}
-	for i := range this.QueueMax {
-		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
-			return false
+	return 0
+}
+
+// The accounting of the swing-store "export data" held in the module state.
+type SwingStoreExportDataStats struct {
+	// The sizes by kind of entry, sorted by kind.
+	Sizes []SwingStoreExportDataSize `protobuf:"bytes,1,rep,name=sizes,proto3" json:"sizes" yaml:"sizes"`
+}
+
+func (m *SwingStoreExportDataStats) Reset()         { *m = SwingStoreExportDataStats{} }
+func (m *SwingStoreExportDataStats) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportDataStats) ProtoMessage()    {}
+func (*SwingStoreExportDataStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{14}
+}
+func (m *SwingStoreExportDataStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportDataStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportDataStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *StringBeans) Equal(that interface{}) bool {
-	if that == nil {
+func (m *SwingStoreExportDataStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportDataStats.Merge(m, src)
+}
+func (m *SwingStoreExportDataStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportDataStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportDataStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportDataStats proto.InternalMessageInfo
+
+func (m *SwingStoreExportDataStats) GetSizes() []SwingStoreExportDataSize {
+	if m != nil {
+		return m.Sizes
+	}
+	return nil
+}
+
+// The bytes of swing-store "export data" written in a block, held in the
+// module state to be checked against the export_data_block_budget param.
+type SwingStoreExportDataBlockUsage struct {
+	// The block in which the bytes were written.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The total size in bytes of the keys and values written in the block.
+	WrittenBytes uint64 `protobuf:"varint,2,opt,name=written_bytes,json=writtenBytes,proto3" json:"written_bytes,omitempty"`
+}
+
+func (m *SwingStoreExportDataBlockUsage) Reset()         { *m = SwingStoreExportDataBlockUsage{} }
+func (m *SwingStoreExportDataBlockUsage) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportDataBlockUsage) ProtoMessage()    {}
+func (*SwingStoreExportDataBlockUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{15}
+}
+func (m *SwingStoreExportDataBlockUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportDataBlockUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportDataBlockUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreExportDataBlockUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportDataBlockUsage.Merge(m, src)
+}
+func (m *SwingStoreExportDataBlockUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportDataBlockUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportDataBlockUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportDataBlockUsage proto.InternalMessageInfo
+
+func (m *SwingStoreExportDataBlockUsage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SwingStoreExportDataBlockUsage) GetWrittenBytes() uint64 {
+	if m != nil {
+		return m.WrittenBytes
+	}
+	return 0
+}
+
+// EventSwingStoreExportDataAlarm is emitted when an update of the swing-store
+// "export data" exceeds the export_data_block_budget param or has invalid
+// keys.  The JS swing-store has already committed the update, so it is applied
+// regardless, and the event lets operators notice the anomaly.
+type EventSwingStoreExportDataAlarm struct {
+	// The bytes written in the block so far, including the update.
+	BlockWrittenBytes uint64 `protobuf:"varint,1,opt,name=block_written_bytes,json=blockWrittenBytes,proto3" json:"block_written_bytes,omitempty"`
+	// The export_data_block_budget param, or zero if unlimited.
+	BlockBudget uint64 `protobuf:"varint,2,opt,name=block_budget,json=blockBudget,proto3" json:"block_budget,omitempty"`
+	// The keys of the update which do not follow the grammar of the "export
+	// data" keys.
+	InvalidKeys []string `protobuf:"bytes,3,rep,name=invalid_keys,json=invalidKeys,proto3" json:"invalid_keys,omitempty"`
+}
+
+func (m *EventSwingStoreExportDataAlarm) Reset()         { *m = EventSwingStoreExportDataAlarm{} }
+func (m *EventSwingStoreExportDataAlarm) String() string { return proto.CompactTextString(m) }
+func (*EventSwingStoreExportDataAlarm) ProtoMessage()    {}
+func (*EventSwingStoreExportDataAlarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{16}
+}
+func (m *EventSwingStoreExportDataAlarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwingStoreExportDataAlarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwingStoreExportDataAlarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwingStoreExportDataAlarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwingStoreExportDataAlarm.Merge(m, src)
+}
+func (m *EventSwingStoreExportDataAlarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwingStoreExportDataAlarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwingStoreExportDataAlarm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwingStoreExportDataAlarm proto.InternalMessageInfo
+
+func (m *EventSwingStoreExportDataAlarm) GetBlockWrittenBytes() uint64 {
+	if m != nil {
+		return m.BlockWrittenBytes
+	}
+	return 0
+}
+
+func (m *EventSwingStoreExportDataAlarm) GetBlockBudget() uint64 {
+	if m != nil {
+		return m.BlockBudget
+	}
+	return 0
+}
+
+func (m *EventSwingStoreExportDataAlarm) GetInvalidKeys() []string {
+	if m != nil {
+		return m.InvalidKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
+	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
+	proto.RegisterType((*HighPrioritySendersProposal)(nil), "agoric.swingset.HighPrioritySendersProposal")
+	proto.RegisterType((*HighPrioritySender)(nil), "agoric.swingset.HighPrioritySender")
+	proto.RegisterType((*EventHighPrioritySenderChanged)(nil), "agoric.swingset.EventHighPrioritySenderChanged")
+	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
+	proto.RegisterType((*State)(nil), "agoric.swingset.State")
+	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
+	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
+	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
+	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
+	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+	proto.RegisterType((*SwingStoreArtifactChunk)(nil), "agoric.swingset.SwingStoreArtifactChunk")
+	proto.RegisterType((*SwingStoreExportDataSize)(nil), "agoric.swingset.SwingStoreExportDataSize")
+	proto.RegisterType((*SwingStoreExportDataStats)(nil), "agoric.swingset.SwingStoreExportDataStats")
+	proto.RegisterType((*SwingStoreExportDataBlockUsage)(nil), "agoric.swingset.SwingStoreExportDataBlockUsage")
+	proto.RegisterType((*EventSwingStoreExportDataAlarm)(nil), "agoric.swingset.EventSwingStoreExportDataAlarm")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+
+var fileDescriptor_ff9c341e0de15f8b = []byte{
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0xdb, 0x46,
+	0x1a, 0x37, 0x6d, 0x49, 0xb1, 0x47, 0xb2, 0x9d, 0x4c, 0x9c, 0x0d, 0xf3, 0xb0, 0xe8, 0x65, 0xb0,
+	0x1b, 0x2f, 0x8c, 0x48, 0x89, 0x83, 0x6c, 0x00, 0x07, 0x39, 0x98, 0x8e, 0x03, 0x2f, 0xb2, 0x59,
+	0x68, 0xc7, 0x71, 0x0b, 0x14, 0x6d, 0x89, 0x91, 0x38, 0xa2, 0x26, 0x16, 0x39, 0x0a, 0x67, 0x6c,
+	0xcb, 0xb9, 0xf5, 0xd4, 0x9e, 0x8a, 0x22, 0x87, 0xa2, 0xc7, 0x00, 0xbd, 0xf5, 0x1f, 0xe8, 0xbf,
+	0x90, 0x63, 0x8e, 0x45, 0x0f, 0x6c, 0xe1, 0xf4, 0x50, 0xe8, 0xe8, 0x63, 0x81, 0x02, 0xc5, 0x3c,
+	0x24, 0xd2, 0x8f, 0x02, 0x2e, 0x8a, 0x9e, 0x34, 0xdf, 0xef, 0x7b, 0xff, 0xf4, 0xcd, 0x47, 0x12,
+	0x54, 0x71, 0xc8, 0x12, 0xda, 0xaa, 0xf3, 0x3d, 0x1a, 0x87, 0x9c, 0x88, 0xd1, 0xa1, 0xd6, 0x4b,
+	0x98, 0x60, 0x70, 0x56, 0xeb, 0x6b, 0x43, 0xf8, 0xea, 0x5c, 0xc8, 0x42, 0xa6, 0x74, 0x75, 0x79,
+	0xd2, 0x66, 0x57, 0xab, 0x2d, 0xc6, 0x23, 0xc6, 0xeb, 0x4d, 0xcc, 0x49, 0x7d, 0xf7, 0x4e, 0x93,
+	0x08, 0x7c, 0xa7, 0xde, 0x62, 0x34, 0xd6, 0x7a, 0xf7, 0x53, 0x0b, 0x9c, 0x5f, 0x63, 0x09, 0x59,
+	0xdf, 0xc5, 0xdd, 0x46, 0xc2, 0x7a, 0x8c, 0xe3, 0x2e, 0x9c, 0x03, 0x45, 0x41, 0x45, 0x97, 0xd8,
+	0xd6, 0x82, 0xb5, 0x38, 0x85, 0xb4, 0x00, 0x17, 0x40, 0x39, 0x20, 0xbc, 0x95, 0xd0, 0x9e, 0xa0,
+	0x2c, 0xb6, 0xc7, 0x95, 0x2e, 0x0f, 0xc1, 0x7b, 0xa0, 0x48, 0x76, 0x71, 0x97, 0xdb, 0x13, 0x0b,
+	0x13, 0x8b, 0xe5, 0xe5, 0x2b, 0xb5, 0x63, 0x35, 0xd6, 0x86, 0x99, 0xbc, 0xc2, 0x9b, 0xd4, 0x19,
+	0x43, 0xda, 0x7a, 0xa5, 0xf0, 0xd9, 0x6b, 0x67, 0xcc, 0xe5, 0x60, 0x72, 0xa8, 0x86, 0x2b, 0xa0,
+	0xf2, 0x9c, 0xb3, 0xd8, 0xef, 0x91, 0x24, 0xa2, 0x82, 0xeb, 0x3a, 0xbc, 0xcb, 0x87, 0xa9, 0x73,
+	0x71, 0x1f, 0x47, 0xdd, 0x15, 0x37, 0xaf, 0x75, 0x51, 0x59, 0x8a, 0x0d, 0x2d, 0xc1, 0x25, 0x70,
+	0xee, 0x39, 0xf7, 0x5b, 0x2c, 0x20, 0xba, 0x44, 0x0f, 0x1e, 0xa6, 0xce, 0xcc, 0xd0, 0x4d, 0x29,
+	0x5c, 0x54, 0x7a, 0xce, 0xd7, 0xe4, 0xe1, 0x6b, 0x0b, 0x5c, 0xdb, 0xa0, 0x61, 0xa7, 0x91, 0x50,
+	0x96, 0x50, 0xb1, 0xbf, 0x49, 0xe2, 0x80, 0x24, 0xfc, 0x4f, 0x33, 0x71, 0x1d, 0x4c, 0xc5, 0x38,
+	0x22, 0xbc, 0x87, 0x5b, 0xc4, 0x9e, 0x50, 0xfa, 0x0c, 0x80, 0xe7, 0xc1, 0x04, 0x0e, 0x02, 0xbb,
+	0xb0, 0x30, 0xb1, 0x38, 0x85, 0xe4, 0x11, 0xfe, 0x0d, 0x94, 0x12, 0x12, 0xb1, 0x5d, 0x62, 0x17,
+	0x15, 0x68, 0x24, 0x43, 0xcd, 0x2b, 0x0b, 0xc0, 0x93, 0x55, 0xc2, 0xfb, 0xe0, 0x1c, 0x0e, 0x82,
+	0x84, 0xf0, 0x21, 0x41, 0xf3, 0x83, 0xd4, 0x19, 0x42, 0x59, 0xd3, 0x06, 0x70, 0xd1, 0x50, 0x05,
+	0xd7, 0x00, 0x18, 0x15, 0xc3, 0xed, 0x71, 0x99, 0xd1, 0xbb, 0x31, 0x48, 0x9d, 0x1c, 0x7a, 0x98,
+	0x3a, 0x17, 0xb4, 0x7b, 0x86, 0xb9, 0x28, 0x67, 0xe0, 0x7e, 0x6e, 0x81, 0xea, 0xfa, 0x2e, 0x89,
+	0xc5, 0xc9, 0xca, 0xd6, 0x3a, 0x38, 0x0e, 0x49, 0x00, 0xed, 0x63, 0x05, 0x66, 0x15, 0x1c, 0xe1,
+	0x67, 0xfc, 0x38, 0x3f, 0x73, 0xa0, 0x88, 0x83, 0x80, 0x04, 0x8a, 0xb9, 0x49, 0xa4, 0x05, 0x58,
+	0x3d, 0x52, 0xb5, 0x26, 0x2f, 0x5f, 0xd0, 0x41, 0x01, 0x94, 0x1a, 0x38, 0xc1, 0x11, 0x87, 0x1b,
+	0x60, 0xa6, 0x49, 0x70, 0xcc, 0xe5, 0x88, 0xf8, 0x3b, 0x31, 0x15, 0xb6, 0xa5, 0x26, 0xf2, 0xfa,
+	0x89, 0x89, 0xdc, 0x14, 0x09, 0x8d, 0x43, 0x4f, 0x1a, 0x9b, 0xa1, 0xac, 0x28, 0xcf, 0x06, 0x49,
+	0xb6, 0x62, 0x2a, 0xe0, 0x0b, 0x30, 0xd3, 0x26, 0x44, 0xc5, 0xf0, 0x7b, 0x09, 0x55, 0xd5, 0xea,
+	0xd9, 0xd6, 0x17, 0xab, 0x26, 0x2f, 0x56, 0xcd, 0x5c, 0xac, 0xda, 0x1a, 0xa3, 0xb1, 0x77, 0x5b,
+	0x86, 0xf9, 0xe6, 0x07, 0x67, 0x31, 0xa4, 0xa2, 0xb3, 0xd3, 0xac, 0xb5, 0x58, 0x54, 0x37, 0xb7,
+	0x50, 0xff, 0xdc, 0xe2, 0xc1, 0x76, 0x5d, 0xec, 0xf7, 0x08, 0x57, 0x0e, 0x1c, 0x55, 0xda, 0x84,
+	0xc8, 0x6c, 0x0d, 0x99, 0x00, 0xde, 0x06, 0x73, 0x4d, 0xc6, 0x04, 0x17, 0x09, 0xee, 0xf9, 0xbb,
+	0x58, 0xf8, 0x2d, 0x16, 0xb7, 0x69, 0x68, 0xc6, 0x08, 0x8e, 0x74, 0xef, 0x61, 0xb1, 0xa6, 0x34,
+	0xf0, 0x09, 0x98, 0xed, 0xb1, 0x3d, 0x92, 0xf8, 0xed, 0x2e, 0x0e, 0xfd, 0x36, 0x31, 0xf4, 0x94,
+	0x97, 0xe7, 0x4f, 0xf4, 0xdb, 0x90, 0x76, 0x8f, 0xbb, 0x38, 0x7c, 0x4c, 0x88, 0x69, 0x78, 0xba,
+	0x97, 0xc3, 0x38, 0x7c, 0x08, 0xa6, 0x5e, 0xec, 0x90, 0x1d, 0xe2, 0x47, 0xb8, 0xaf, 0xa6, 0xb1,
+	0xbc, 0x7c, 0xf5, 0x44, 0x98, 0xff, 0x4b, 0x8b, 0x4d, 0xfa, 0x72, 0x18, 0x63, 0x52, 0xb9, 0x3c,
+	0xc5, 0x7d, 0xb8, 0x04, 0x60, 0x84, 0xfb, 0x3e, 0x8d, 0x9b, 0x6c, 0x27, 0x0e, 0xd4, 0x1f, 0x20,
+	0xfa, 0x76, 0x69, 0xc1, 0x5a, 0x2c, 0xa2, 0xd9, 0x08, 0xf7, 0xff, 0xa3, 0x15, 0x0d, 0x92, 0x3c,
+	0xeb, 0xc3, 0x08, 0x5c, 0x1b, 0x1a, 0x46, 0x24, 0xea, 0x31, 0xd6, 0xf5, 0x75, 0xee, 0x04, 0x0b,
+	0xca, 0xec, 0x73, 0x6a, 0xaa, 0x6b, 0x32, 0xc3, 0xf7, 0xa9, 0xf3, 0xcf, 0x33, 0xf0, 0xf9, 0x88,
+	0xb4, 0x90, 0x6d, 0x42, 0x3e, 0xd5, 0x11, 0x55, 0xa9, 0x48, 0xc6, 0x83, 0xf7, 0x81, 0x4d, 0xfa,
+	0x3d, 0x96, 0x08, 0x3f, 0xc0, 0x02, 0xfb, 0xcd, 0x2e, 0x6b, 0x6d, 0xfb, 0xcd, 0x9d, 0x20, 0x24,
+	0xc2, 0x9e, 0x5c, 0xb0, 0x16, 0x0b, 0xe8, 0x92, 0xd6, 0x3f, 0xc2, 0x02, 0x7b, 0x52, 0xeb, 0x29,
+	0xe5, 0xca, 0xe4, 0x57, 0xaf, 0x9d, 0xb1, 0x9f, 0x5f, 0x3b, 0x96, 0xfb, 0x3f, 0x50, 0xdc, 0x14,
+	0x58, 0x10, 0xb8, 0x0e, 0xa6, 0x75, 0xa9, 0xb8, 0xdb, 0x65, 0x7b, 0x24, 0xb0, 0xad, 0x33, 0x52,
+	0x55, 0x51, 0x6e, 0xab, 0xda, 0xcb, 0xed, 0x82, 0x72, 0x6e, 0x04, 0xe5, 0x66, 0xd8, 0x26, 0xfb,
+	0xe6, 0xb6, 0xc8, 0x23, 0x5c, 0x07, 0x45, 0x35, 0x90, 0x66, 0x99, 0xd5, 0x0d, 0x19, 0x37, 0xcf,
+	0x40, 0xc6, 0x16, 0x8d, 0x05, 0xd2, 0xde, 0x2b, 0x05, 0x55, 0xfd, 0x2b, 0x0b, 0x54, 0xf2, 0x13,
+	0x00, 0xe7, 0x01, 0xc8, 0x26, 0xc7, 0xa4, 0x9d, 0x1a, 0xcd, 0x03, 0xfc, 0x08, 0x4c, 0xb4, 0xc9,
+	0x5f, 0x32, 0xf2, 0x32, 0xae, 0x29, 0xea, 0x3e, 0x98, 0x1a, 0x71, 0x74, 0x0a, 0x01, 0x10, 0x14,
+	0x38, 0x7d, 0xa9, 0xb7, 0x44, 0x11, 0xa9, 0xb3, 0x71, 0xfc, 0xd5, 0x02, 0xa5, 0xf5, 0x50, 0xed,
+	0x93, 0x07, 0x60, 0x32, 0xa6, 0xad, 0x6d, 0xb9, 0x0d, 0xcc, 0x2e, 0x74, 0x06, 0xa9, 0x33, 0xc2,
+	0x0e, 0x53, 0x67, 0xd6, 0x6c, 0x33, 0x83, 0xb8, 0x68, 0xa4, 0x84, 0x1f, 0x82, 0x42, 0x8f, 0x90,
+	0x44, 0x65, 0xa8, 0x78, 0x1b, 0x83, 0xd4, 0x51, 0xf2, 0x61, 0xea, 0x94, 0xb5, 0x93, 0x94, 0xdc,
+	0x5f, 0x52, 0xe7, 0xd6, 0x19, 0xda, 0x5b, 0x6d, 0xb5, 0x56, 0xf5, 0x92, 0x43, 0x2a, 0x0a, 0x44,
+	0xa0, 0x9c, 0x51, 0xac, 0x1f, 0x8d, 0x53, 0xde, 0x9d, 0x83, 0xd4, 0x01, 0xa3, 0x7f, 0x82, 0xcb,
+	0xdd, 0x3b, 0x62, 0x3d, 0xb7, 0x7b, 0x33, 0xcc, 0x45, 0x39, 0x03, 0xd5, 0xff, 0x98, 0x2b, 0x00,
+	0xdc, 0x94, 0x53, 0xb6, 0x29, 0x58, 0x42, 0x56, 0x13, 0x41, 0xdb, 0xb8, 0x25, 0xe0, 0x12, 0x28,
+	0xe4, 0x68, 0xb8, 0x2c, 0xbb, 0x31, 0x14, 0x94, 0xb3, 0x85, 0xee, 0x22, 0x05, 0x4a, 0x63, 0x79,
+	0x15, 0x4c, 0xeb, 0xca, 0x58, 0xca, 0x99, 0xb1, 0x94, 0x5c, 0xa4, 0x40, 0x93, 0xf5, 0xa7, 0x71,
+	0x70, 0xf9, 0x64, 0xda, 0xb5, 0xce, 0x4e, 0xbc, 0xfd, 0xc7, 0x72, 0xdf, 0x05, 0x25, 0xd6, 0x6e,
+	0x73, 0x22, 0x54, 0xf6, 0x82, 0x77, 0x6d, 0x90, 0x3a, 0x06, 0x39, 0x4c, 0x9d, 0x69, 0xed, 0xa0,
+	0x65, 0x17, 0x19, 0x05, 0x7c, 0x06, 0x66, 0x5b, 0x2c, 0xea, 0x49, 0x7e, 0x49, 0xa0, 0xae, 0xb1,
+	0xda, 0x8b, 0x15, 0x6f, 0x69, 0x90, 0x3a, 0x33, 0x99, 0xea, 0x91, 0xee, 0xe2, 0x92, 0x8e, 0x72,
+	0x14, 0x77, 0xd1, 0x31, 0x43, 0xf8, 0x5f, 0x30, 0x8d, 0x4d, 0x23, 0xbe, 0x1a, 0xb6, 0x82, 0xaa,
+	0xe8, 0xe6, 0x20, 0x75, 0x2a, 0x43, 0x85, 0x1c, 0xcf, 0xec, 0x05, 0x24, 0x8f, 0xba, 0xe8, 0x88,
+	0x11, 0x7c, 0x08, 0x4a, 0xbc, 0x83, 0x97, 0xef, 0xfd, 0xdb, 0x2e, 0xaa, 0xd2, 0xfe, 0x71, 0x90,
+	0x3a, 0xa5, 0x4d, 0x85, 0xc8, 0x16, 0xb5, 0x2e, 0x6b, 0x51, 0xcb, 0x2e, 0x32, 0x0a, 0x43, 0xf3,
+	0xb7, 0x16, 0xb0, 0x33, 0x9a, 0xd7, 0x47, 0x6b, 0x49, 0x65, 0x58, 0x02, 0x85, 0x6d, 0x1a, 0x07,
+	0x79, 0x9e, 0xa5, 0x9c, 0xf1, 0x2c, 0x25, 0x17, 0x29, 0x50, 0xbe, 0x26, 0x90, 0x58, 0x24, 0x94,
+	0x70, 0x43, 0xb4, 0x7a, 0x4d, 0x30, 0x50, 0xf6, 0x9a, 0x60, 0x00, 0x17, 0x0d, 0x55, 0xb0, 0x0e,
+	0x8a, 0xcd, 0x7d, 0x41, 0xb8, 0x62, 0xb8, 0xe0, 0x5d, 0x19, 0xa4, 0x8e, 0x06, 0x0e, 0x53, 0xa7,
+	0xa2, 0x9d, 0x94, 0xe8, 0x22, 0x0d, 0x9b, 0x6b, 0xf9, 0x89, 0x05, 0xae, 0x9c, 0x5a, 0xb9, 0xc0,
+	0x82, 0xc3, 0x8f, 0x41, 0x51, 0x32, 0xcc, 0xcd, 0xbe, 0xfc, 0xd7, 0xc9, 0x27, 0xf2, 0xef, 0x34,
+	0xed, 0xcd, 0xcb, 0x25, 0x23, 0x6b, 0x50, 0xfe, 0x59, 0x0d, 0x4a, 0x74, 0x91, 0x86, 0x4d, 0x0d,
+	0x1d, 0x50, 0x3d, 0x2d, 0x8e, 0xda, 0xe9, 0x5b, 0x1c, 0x87, 0x04, 0xfe, 0x1d, 0x54, 0xf4, 0xfe,
+	0xef, 0x10, 0x1a, 0x76, 0x84, 0xa2, 0x72, 0x02, 0x95, 0x15, 0xb6, 0xa1, 0x20, 0x78, 0x03, 0x4c,
+	0xef, 0x25, 0x54, 0x08, 0x12, 0xfb, 0x9a, 0x07, 0x45, 0x1f, 0xaa, 0x18, 0xd0, 0x53, 0xbd, 0x7f,
+	0x39, 0x7c, 0x0d, 0x3a, 0x2d, 0xdf, 0x6a, 0x17, 0x27, 0x11, 0xac, 0x81, 0x8b, 0x3a, 0xd5, 0xd1,
+	0x68, 0x96, 0x8a, 0x76, 0x41, 0xa9, 0xde, 0xcf, 0x85, 0xcc, 0x4a, 0x33, 0x8f, 0x26, 0x9d, 0xb6,
+	0xdc, 0xcc, 0x1e, 0x48, 0xd2, 0x84, 0xc6, 0xbb, 0xb8, 0x4b, 0x03, 0x7f, 0x9b, 0xec, 0x9b, 0xad,
+	0x82, 0xca, 0x06, 0x7b, 0x42, 0xf6, 0xb9, 0xb7, 0xf5, 0xe6, 0xa0, 0x6a, 0xbd, 0x3d, 0xa8, 0x5a,
+	0x3f, 0x1e, 0x54, 0xad, 0x2f, 0xde, 0x55, 0xc7, 0xde, 0xbe, 0xab, 0x8e, 0x7d, 0xf7, 0xae, 0x3a,
+	0xf6, 0xc1, 0x83, 0xdc, 0x1a, 0x5b, 0xd5, 0x5f, 0x19, 0xfa, 0x4f, 0x50, 0x6b, 0x2c, 0x64, 0x5d,
+	0x1c, 0x87, 0xc3, 0xfd, 0xd6, 0xcf, 0x3e, 0x40, 0xd4, 0x7e, 0x6b, 0x96, 0xd4, 0x77, 0xc3, 0xdd,
+	0xdf, 0x06, 0x00, 0xee, 0xe5, 0x44, 0xd0, 0xa0, 0x0c, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
+		return false
+	}
+	for i := range this.BeansPerUnit {
+		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
+			return false
+		}
+	}
+	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
+		return false
+	}
+	for i := range this.FeeUnitPrice {
+		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
+			return false
+		}
+	}
+	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
+		return false
+	}
+	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
+		return false
+	}
+	for i := range this.PowerFlagFees {
+		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
+			return false
+		}
+	}
+	if len(this.QueueMax) != len(that1.QueueMax) {
+		return false
+	}
+	for i := range this.QueueMax {
+		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
+			return false
+		}
+	}
+	if this.MaxInboundPerTx != that1.MaxInboundPerTx {
+		return false
+	}
+	if !this.InboundMempoolQueueRatio.Equal(that1.InboundMempoolQueueRatio) {
+		return false
+	}
+	if this.ExportDataBlockBudget != that1.ExportDataBlockBudget {
+		return false
+	}
+	return true
+}
+func (this *StringBeans) Equal(that interface{}) bool {
+	if that == nil {
 		return this == nil
 	}
 
@@ -764,6 +1346,65 @@ func (this *QueueSize) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SwingStoreExportDataSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwingStoreExportDataSize)
+	if !ok {
+		that2, ok := that.(SwingStoreExportDataSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Entries != that1.Entries {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	return true
+}
+func (this *SwingStoreExportDataStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwingStoreExportDataStats)
+	if !ok {
+		that2, ok := that.(SwingStoreExportDataStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sizes) != len(that1.Sizes) {
+		return false
+	}
+	for i := range this.Sizes {
+		if !this.Sizes[i].Equal(&that1.Sizes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -852,7 +1493,7 @@ func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *HighPrioritySendersProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -862,69 +1503,246 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *HighPrioritySendersProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HighPrioritySendersProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QueueMax) > 0 {
-		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.Remove[iNdEx])))
 			i--
 			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.PowerFlagFees) > 0 {
-		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.Add[iNdEx])))
 			i--
 			dAtA[i] = 0x22
 		}
 	}
-	if len(m.BootstrapVatConfig) > 0 {
-		i -= len(m.BootstrapVatConfig)
-		copy(dAtA[i:], m.BootstrapVatConfig)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Namespace)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FeeUnitPrice) > 0 {
-		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.BeansPerUnit) > 0 {
-		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighPrioritySender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighPrioritySender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighPrioritySender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHighPrioritySenderChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHighPrioritySenderChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHighPrioritySenderChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExportDataBlockBudget != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExportDataBlockBudget))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.InboundMempoolQueueRatio.Size()
+		i -= size
+		if _, err := m.InboundMempoolQueueRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxInboundPerTx != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.MaxInboundPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.QueueMax) > 0 {
+		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BootstrapVatConfig) > 0 {
+		i -= len(m.BootstrapVatConfig)
+		copy(dAtA[i:], m.BootstrapVatConfig)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BeansPerUnit) > 0 {
+		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1177,68 +1995,352 @@ func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwingset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SwingStoreArtifactChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CoreEvalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SwingStoreArtifactChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifactChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.ArtifactSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ArtifactSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Evals) > 0 {
-		for _, e := range m.Evals {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+	if len(m.CompressedData) > 0 {
+		i -= len(m.CompressedData)
+		copy(dAtA[i:], m.CompressedData)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.CompressedData)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Offset != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CoreEval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwingStoreExportDataSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportDataSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportDataSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JsonPermits)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.Bytes != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.JsCode)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.Entries != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BeansPerUnit) > 0 {
-		for _, e := range m.BeansPerUnit {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+func (m *SwingStoreExportDataStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportDataStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportDataStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sizes) > 0 {
+		for iNdEx := len(m.Sizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreExportDataBlockUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportDataBlockUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportDataBlockUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WrittenBytes != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.WrittenBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwingStoreExportDataAlarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwingStoreExportDataAlarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwingStoreExportDataAlarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidKeys) > 0 {
+		for iNdEx := len(m.InvalidKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InvalidKeys[iNdEx])
+			copy(dAtA[i:], m.InvalidKeys[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.InvalidKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockBudget != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockBudget))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockWrittenBytes != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockWrittenBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwingset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CoreEvalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Evals) > 0 {
+		for _, e := range m.Evals {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoreEval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPermits)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.JsCode)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *HighPrioritySendersProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HighPrioritySender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventHighPrioritySenderChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Added {
+		n += 2
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BeansPerUnit) > 0 {
+		for _, e := range m.BeansPerUnit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
 	}
 	if len(m.FeeUnitPrice) > 0 {
 		for _, e := range m.FeeUnitPrice {
@@ -1262,6 +2364,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovSwingset(uint64(l))
 		}
 	}
+	if m.MaxInboundPerTx != 0 {
+		n += 1 + sovSwingset(uint64(m.MaxInboundPerTx))
+	}
+	l = m.InboundMempoolQueueRatio.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.ExportDataBlockBudget != 0 {
+		n += 1 + sovSwingset(uint64(m.ExportDataBlockBudget))
+	}
 	return n
 }
 
@@ -1370,28 +2480,125 @@ func (m *SwingStoreArtifact) Size() (n int) {
 	return n
 }
 
-func sovSwingset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SwingStoreArtifactChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovSwingset(uint64(m.Offset))
+	}
+	l = len(m.CompressedData)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.ArtifactSize != 0 {
+		n += 1 + sovSwingset(uint64(m.ArtifactSize))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
 }
-func sozSwingset(x uint64) (n int) {
-	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SwingStoreExportDataSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovSwingset(uint64(m.Entries))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovSwingset(uint64(m.Bytes))
+	}
+	return n
 }
-func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
+
+func (m *SwingStoreExportDataStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sizes) > 0 {
+		for _, e := range m.Sizes {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwingStoreExportDataBlockUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	if m.WrittenBytes != 0 {
+		n += 1 + sovSwingset(uint64(m.WrittenBytes))
+	}
+	return n
+}
+
+func (m *EventSwingStoreExportDataAlarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockWrittenBytes != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockWrittenBytes))
+	}
+	if m.BlockBudget != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockBudget))
+	}
+	if len(m.InvalidKeys) > 0 {
+		for _, s := range m.InvalidKeys {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSwingset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwingset(x uint64) (n int) {
+	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1432,14 +2639,1224 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighPrioritySendersProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighPrioritySendersProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighPrioritySendersProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighPrioritySender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighPrioritySender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighPrioritySender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHighPrioritySenderChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHighPrioritySenderChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHighPrioritySenderChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
+			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
+			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
+			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueMax = append(m.QueueMax, QueueSize{})
+			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInboundPerTx", wireType)
+			}
+			m.MaxInboundPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInboundPerTx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundMempoolQueueRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundMempoolQueueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportDataBlockBudget", wireType)
+			}
+			m.ExportDataBlockBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExportDataBlockBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
+			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringBeans) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringBeans: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringBeans: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beans", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerFlagFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerFlagFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1467,11 +3884,11 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PowerFlag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1498,8 +3915,8 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evals = append(m.Evals, CoreEval{})
-			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1524,7 +3941,7 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreEval) Unmarshal(dAtA []byte) error {
+func (m *QueueSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1547,15 +3964,15 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1583,13 +4000,13 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1599,24 +4016,11 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Size_ |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JsCode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -1638,7 +4042,7 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Egress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +4065,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1681,31 +4085,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
-			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1715,29 +4117,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
-			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1765,13 +4167,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwingStoreArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwingStoreArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1781,31 +4233,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
-			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1815,24 +4265,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueMax = append(m.QueueMax, QueueSize{})
-			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1856,7 +4306,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreArtifactChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,17 +4329,17 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreArtifactChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreArtifactChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1899,81 +4349,48 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
-			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwingset(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StringBeans) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StringBeans: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringBeans: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1983,29 +4400,50 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.CompressedData = append(m.CompressedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedData == nil {
+				m.CompressedData = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactSize", wireType)
+			}
+			m.ArtifactSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArtifactSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2015,24 +4453,24 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Beans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sha256 = append(m.Sha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha256 == nil {
+				m.Sha256 = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2056,7 +4494,7 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreExportDataSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2079,15 +4517,15 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerFlagFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreExportDataSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerFlagFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreExportDataSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2115,13 +4553,13 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlag = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var msglen int
+			m.Entries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2131,26 +4569,30 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Entries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -2172,7 +4614,7 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueSize) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreExportDataStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,17 +4637,17 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreExportDataStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreExportDataStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2215,43 +4657,26 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwingset
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Sizes = append(m.Sizes, SwingStoreExportDataSize{})
+			if err := m.Sizes[len(m.Sizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -2273,7 +4698,7 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreExportDataBlockUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,17 +4721,17 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreExportDataBlockUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreExportDataBlockUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2316,63 +4741,16 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwingset
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
 			}
-			var stringLen uint64
+			m.WrittenBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2382,24 +4760,11 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WrittenBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -2421,7 +4786,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
+func (m *EventSwingStoreExportDataAlarm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2444,17 +4809,17 @@ func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwingStoreArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSwingStoreExportDataAlarm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwingStoreArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSwingStoreExportDataAlarm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWrittenBytes", wireType)
 			}
-			var stringLen uint64
+			m.BlockWrittenBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2464,29 +4829,35 @@ func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockWrittenBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBudget", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BlockBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidKeys", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2496,25 +4867,23 @@ func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.InvalidKeys = append(m.InvalidKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
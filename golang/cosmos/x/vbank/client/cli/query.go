@@ -21,6 +21,11 @@ func GetQueryCmd() *cobra.Command {
 	vbankQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryState(),
+		GetCmdQueryModulePools(),
+		GetCmdQueryEpochPosition(),
+		GetCmdQueryRewardHistory(),
+		GetCmdQueryDenomAllowlist(),
+		GetCmdQueryLastSequence(),
 	)
 
 	return vbankQueryCmd
@@ -77,3 +82,133 @@ func GetCmdQueryState() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryModulePools implements the query module-pools command.
+func GetCmdQueryModulePools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-pools",
+		Args:  cobra.NoArgs,
+		Short: "Query the balances of the vbank module pools",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModulePools(cmd.Context(), &types.QueryModulePoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEpochPosition implements the query epoch-position command.
+func GetCmdQueryEpochPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-position",
+		Args:  cobra.NoArgs,
+		Short: "Query the position of the current block in the vbank reward epoch",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochPosition(cmd.Context(), &types.QueryEpochPositionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRewardHistory implements the query reward-history command.
+func GetCmdQueryRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the vbank rewards of recent epochs",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardHistory(cmd.Context(), &types.QueryRewardHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomAllowlist implements the query denom-allowlist command.
+func GetCmdQueryDenomAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-allowlist",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms which the vbank port may give or grab",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomAllowlist(cmd.Context(), &types.QueryDenomAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLastSequence implements the query last-sequence command.
+func GetCmdQueryLastSequence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-sequence",
+		Args:  cobra.NoArgs,
+		Short: "Query the nonce of the last vbank balance update",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastSequence(cmd.Context(), &types.QueryLastSequenceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
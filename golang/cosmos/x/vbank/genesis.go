@@ -21,6 +21,16 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, subscription := range data.Subscriptions {
+		if _, err := sdk.AccAddressFromBech32(subscription.Address); err != nil {
+			return fmt.Errorf("invalid subscription address %s: %w", subscription.Address, err)
+		}
+		if subscription.Denom != "" {
+			if err := sdk.ValidateDenom(subscription.Denom); err != nil {
+				return fmt.Errorf("invalid subscription denom %s: %w", subscription.Denom, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -34,6 +44,9 @@ func DefaultGenesisState() *types.GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
 	keeper.SetParams(ctx, data.GetParams())
 	keeper.SetState(ctx, data.GetState())
+	for _, subscription := range data.GetSubscriptions() {
+		keeper.Subscribe(ctx, sdk.MustAccAddressFromBech32(subscription.Address), subscription.Denom)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -41,5 +54,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	var gs types.GenesisState
 	gs.Params = k.GetParams(ctx)
 	gs.State = k.GetState(ctx)
+	gs.Subscriptions = k.GetAllSubscriptions(ctx)
 	return &gs
 }
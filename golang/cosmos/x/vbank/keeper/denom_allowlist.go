@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// giveBlockUsageKey holds the types.GiveBlockUsage of the last block in which
+// coins were given through the vbank port. A record of an earlier block counts
+// as nothing given in the current one.
+const giveBlockUsageKey = "giveBlockUsage"
+
+// givenThisBlock returns the coins given so far in the current block.
+func (k Keeper) givenThisBlock(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(giveBlockUsageKey))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var usage types.GiveBlockUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	if usage.BlockHeight != ctx.BlockHeight() {
+		return sdk.NewCoins()
+	}
+	return usage.Given
+}
+
+// CheckGrabAllowed returns an error unless the denom allowances allow
+// VBANK_GRAB of the coins.
+func (k Keeper) CheckGrabAllowed(ctx sdk.Context, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		allowance, found := params.GetDenomAllowance(coin.Denom)
+		if !found || !allowance.Grab {
+			return fmt.Errorf("denom %s is not allowed for VBANK_GRAB", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// CheckGiveAllowed returns an error unless the denom allowances allow
+// VBANK_GIVE of the coins, including within the per-block limits.
+func (k Keeper) CheckGiveAllowed(ctx sdk.Context, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	given := k.givenThisBlock(ctx)
+	for _, coin := range coins {
+		allowance, found := params.GetDenomAllowance(coin.Denom)
+		if !found || !allowance.Give {
+			return fmt.Errorf("denom %s is not allowed for VBANK_GIVE", coin.Denom)
+		}
+		if !allowance.HasGiveLimit() {
+			continue
+		}
+		limit := allowance.GiveLimitPerBlock
+		remaining := limit.Sub(given.AmountOf(coin.Denom))
+		if coin.Amount.GT(remaining) {
+			return fmt.Errorf("VBANK_GIVE of %s exceeds the remaining block limit (%s of %s%s)", coin, remaining, limit, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// RecordGive adds the coins given by VBANK_GIVE to the per-block usage.
+func (k Keeper) RecordGive(ctx sdk.Context, coins sdk.Coins) {
+	usage := types.GiveBlockUsage{
+		BlockHeight: ctx.BlockHeight(),
+		Given:       k.givenThisBlock(ctx).Add(coins...),
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(giveBlockUsageKey), k.cdc.MustMarshal(&usage))
+}
+
+// DenomAllowlist queries the denoms which VBANK_GIVE and VBANK_GRAB may touch
+func (k Keeper) DenomAllowlist(c context.Context, req *types.QueryDenomAllowlistRequest) (*types.QueryDenomAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	allowances := k.GetParams(ctx).DenomAllowances
+
+	return &types.QueryDenomAllowlistResponse{
+		Restricted:     len(allowances) > 0,
+		Allowances:     allowances,
+		GivenThisBlock: k.givenThisBlock(ctx),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// ibcDenomPrefix prefixes the denoms of IBC vouchers, which are followed by
+// the hex hash of their denom trace.
+const ibcDenomPrefix = "ibc/"
+
+// GetDenomMetadata returns the bank metadata of denom, if any.
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	return k.bankKeeper.GetDenomMetaData(ctx, denom)
+}
+
+// GetDenomTrace returns the IBC denom trace of an "ibc/..." denom. It returns
+// false for other denoms and for unknown traces.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, bool, error) {
+	if !strings.HasPrefix(denom, ibcDenomPrefix) || k.transferKeeper == nil {
+		return ibctransfertypes.DenomTrace{}, false, nil
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibcDenomPrefix))
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, false, fmt.Errorf("invalid IBC denom %s: %s", denom, err)
+	}
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	return trace, found, nil
+}
+
+// SetDenomMetadata sets the bank metadata of a denom which governance has
+// allowed the VM to describe.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+	if !k.GetParams(ctx).IsMetadataSettable(metadata.Base) {
+		return fmt.Errorf("metadata of denom %s is not settable", metadata.Base)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
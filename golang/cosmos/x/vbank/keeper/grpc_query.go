@@ -5,8 +5,17 @@ import (
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// modulePoolNames are the vbank module pools reported by ModulePools, sorted
+// by name.
+var modulePoolNames = []string{
+	types.GiveawayPoolName,
+	types.ProvisionPoolName,
+	types.ReservePoolName,
+}
+
 var _ types.QueryServer = Keeper{}
 
 // Params queries params of distribution module
@@ -25,3 +34,62 @@ func (k Keeper) State(c context.Context, req *types.QueryStateRequest) (*types.Q
 
 	return &types.QueryStateResponse{State: state}, nil
 }
+
+// ModulePools queries the balances of the vbank module pools
+func (k Keeper) ModulePools(c context.Context, req *types.QueryModulePoolsRequest) (*types.QueryModulePoolsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	pools := make([]types.ModulePool, 0, len(modulePoolNames))
+	for _, name := range modulePoolNames {
+		addr := authtypes.NewModuleAddress(name)
+		pools = append(pools, types.ModulePool{
+			Name:     name,
+			Address:  addr.String(),
+			Balances: k.GetAllBalances(ctx, addr),
+		})
+	}
+
+	return &types.QueryModulePoolsResponse{Pools: pools}, nil
+}
+
+// EpochPosition queries the position of the current block in the reward epoch
+func (k Keeper) EpochPosition(c context.Context, req *types.QueryEpochPositionRequest) (*types.QueryEpochPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	state := k.GetState(ctx)
+	params := k.GetParams(ctx)
+
+	// DistributeRewards starts a new epoch once the epoch duration has elapsed,
+	// which is every block for a zero duration.
+	height := ctx.BlockHeight()
+	start := state.LastRewardDistributionBlock
+	epochBlocks := params.RewardEpochDurationBlocks
+	if epochBlocks == 0 {
+		epochBlocks = 1
+	}
+	left := start + params.GetSmoothingBlocks() - 1 - height
+	if left < 0 {
+		left = 0
+	}
+
+	return &types.QueryEpochPositionResponse{
+		BlockHeight:         height,
+		EpochStartBlock:     start,
+		NextEpochBlock:      start + epochBlocks,
+		SmoothingBlocksLeft: left,
+	}, nil
+}
+
+// RewardHistory queries the rewards distributed in recent epochs
+func (k Keeper) RewardHistory(c context.Context, req *types.QueryRewardHistoryRequest) (*types.QueryRewardHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epochs := k.GetRewardHistory(ctx)
+
+	return &types.QueryRewardHistoryResponse{Epochs: epochs}, nil
+}
+
+// LastSequence queries the nonce of the last balance update sent to the VM
+func (k Keeper) LastSequence(c context.Context, req *types.QueryLastSequenceRequest) (*types.QueryLastSequenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	state := k.GetState(ctx)
+
+	return &types.QueryLastSequenceResponse{LastSequence: state.LastSequence}, nil
+}
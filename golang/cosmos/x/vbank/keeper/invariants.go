@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// RegisterInvariants registers all vbank invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reward-pool", RewardPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reward-block-amount", RewardBlockAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-sequence", LastSequenceInvariant(k))
+}
+
+// AllInvariants runs all vbank invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			RewardPoolInvariant(k),
+			RewardBlockAmountInvariant(k),
+			LastSequenceInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// getModuleBalance returns the balance of the vbank module account.
+func (k Keeper) getModuleBalance(ctx sdk.Context) sdk.Coins {
+	return k.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+}
+
+// RewardPoolInvariant checks that the manually tracked reward pool is covered
+// by the balance of the vbank module account.
+func RewardPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pool := k.GetState(ctx).RewardPool
+		balance := k.getModuleBalance(ctx)
+		broken := !balance.IsAllGTE(pool)
+		return sdk.FormatInvariant(types.ModuleName, "reward-pool", fmt.Sprintf(
+			"\treward pool: %s\n\tmodule account balance: %s\n", pool, balance,
+		)), broken
+	}
+}
+
+// epochRewardPool returns an upper bound of the reward pool at the start of
+// the current epoch, namely the current pool plus what the epoch distributed
+// so far, or false if the epoch is not in the reward history.
+func (k Keeper) epochRewardPool(ctx sdk.Context, state types.State) (sdk.Coins, bool) {
+	bz := k.rewardHistoryStore(ctx).Get(rewardEpochKey(state.LastRewardDistributionBlock))
+	if bz == nil {
+		return nil, false
+	}
+	var epoch types.RewardEpoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return state.RewardPool.Add(epoch.Distributed...), true
+}
+
+// RewardBlockAmountInvariant checks that the per-block reward amount does not
+// exceed the reward pool from which the current epoch draws. The amount
+// legitimately exceeds what remains of the pool near the end of an epoch, so
+// it is compared with the pool at the start of the epoch.
+func RewardBlockAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		state := k.GetState(ctx)
+		pool, ok := k.epochRewardPool(ctx, state)
+		if !ok {
+			return sdk.FormatInvariant(types.ModuleName, "reward-block-amount",
+				"\tcurrent epoch not in reward history\n"), false
+		}
+		broken := !pool.IsAllGTE(state.RewardBlockAmount)
+		return sdk.FormatInvariant(types.ModuleName, "reward-block-amount", fmt.Sprintf(
+			"\treward block amount: %s\n\tepoch reward pool: %s\n", state.RewardBlockAmount, pool,
+		)), broken
+	}
+}
+
+// LastSequenceInvariant checks that the sequence number for communicating with
+// the VM has not gone back below the highest sequence ever issued.
+func LastSequenceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		last := k.GetState(ctx).LastSequence
+		highWater := k.getSequenceHighWater(ctx)
+		broken := last < highWater
+		return sdk.FormatInvariant(types.ModuleName, "last-sequence", fmt.Sprintf(
+			"\tlast sequence: %d\n\thighest issued sequence: %d\n", last, highWater,
+		)), broken
+	}
+}
+
+// RepairRewardPool brings the reward accounting back within the invariants, by
+// limiting the reward pool to the vbank module account balance and the
+// per-block reward amount to the reward pool of the current epoch.
+func (k Keeper) RepairRewardPool(ctx sdk.Context) {
+	state := k.GetState(ctx)
+	state.RewardPool = minCoins(state.RewardPool, k.getModuleBalance(ctx))
+	pool, ok := k.epochRewardPool(ctx, state)
+	if !ok {
+		pool = state.RewardPool
+	}
+	state.RewardBlockAmount = minCoins(state.RewardBlockAmount, pool)
+	k.SetState(ctx, state)
+}
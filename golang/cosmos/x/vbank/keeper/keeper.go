@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +16,10 @@ import (
 
 const stateKey string = "state"
 
+// sequenceHighWaterKey holds the highest sequence issued by GetNextSequence,
+// against which LastSequenceInvariant checks the state.
+const sequenceHighWaterKey string = "sequenceHighWater"
+
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	storeKey   storetypes.StoreKey
@@ -22,6 +28,8 @@ type Keeper struct {
 
 	accountKeeper         types.AccountKeeper
 	bankKeeper            types.BankKeeper
+	distributionKeeper    types.DistributionKeeper
+	transferKeeper        types.TransferKeeper
 	rewardDistributorName string
 	PushAction            vm.ActionPusher
 }
@@ -30,6 +38,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	transferKeeper types.TransferKeeper,
 	rewardDistributorName string,
 	pushAction vm.ActionPusher,
 ) Keeper {
@@ -45,6 +55,8 @@ func NewKeeper(
 		paramSpace:            paramSpace,
 		accountKeeper:         accountKeeper,
 		bankKeeper:            bankKeeper,
+		distributionKeeper:    distributionKeeper,
+		transferKeeper:        transferKeeper,
 		rewardDistributorName: rewardDistributorName,
 		PushAction:            pushAction,
 	}
@@ -66,6 +78,25 @@ func (k Keeper) SendCoinsToRewardDistributor(ctx sdk.Context, amt sdk.Coins) err
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardDistributorName, amt)
 }
 
+// SendCoinsToRewardDestination sends reward coins from the vbank module
+// account to a reward destination.
+func (k Keeper) SendCoinsToRewardDestination(ctx sdk.Context, dest types.RewardDestination, amt sdk.Coins) error {
+	switch dest.Kind {
+	case types.RewardDestinationFeeCollector:
+		return k.SendCoinsToRewardDistributor(ctx, amt)
+	case types.RewardDestinationCommunityPool:
+		return k.distributionKeeper.FundCommunityPool(ctx, amt, k.GetModuleAccountAddress(ctx, types.ModuleName))
+	case types.RewardDestinationModuleAccount:
+		// The bank keeper panics if the recipient module account is not registered.
+		if len(k.GetModuleAccountAddress(ctx, dest.ModuleName)) == 0 {
+			return fmt.Errorf("reward destination module account %s not found", dest.ModuleName)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, dest.ModuleName, amt)
+	default:
+		return fmt.Errorf("unknown reward destination kind: %q", dest.Kind)
+	}
+}
+
 func (k Keeper) SendCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amt); err != nil {
 		return err
@@ -80,6 +111,12 @@ func (k Keeper) GrabCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) e
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt)
 }
 
+// TransferCoins moves coins directly between two accounts, either of which may
+// be a module account.
+func (k Keeper) TransferCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return k.bankKeeper.SendCoins(ctx, from, to, amt)
+}
+
 func (k Keeper) GetModuleAccountAddress(ctx sdk.Context, name string) sdk.AccAddress {
 	acct := k.accountKeeper.GetModuleAccount(ctx, name)
 	if acct == nil {
@@ -124,5 +161,15 @@ func (k Keeper) GetNextSequence(ctx sdk.Context) uint64 {
 	state := k.GetState(ctx)
 	state.LastSequence = state.GetLastSequence() + 1
 	k.SetState(ctx, state)
+	ctx.KVStore(k.storeKey).Set([]byte(sequenceHighWaterKey), sdk.Uint64ToBigEndian(state.LastSequence))
 	return state.LastSequence
 }
+
+// getSequenceHighWater returns the highest sequence issued by GetNextSequence.
+func (k Keeper) getSequenceHighWater(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(sequenceHighWaterKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
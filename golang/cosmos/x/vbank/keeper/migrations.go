@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new migrator based on the keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, adding the reward destinations
+// param.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.MigrateParams(ctx)
+}
+
+// Migrate2to3 migrates from version 2 to 3, repairing any divergence of the
+// reward pool accounting from the vbank invariants.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.RepairRewardPool(ctx)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, adding the settable metadata
+// denoms param.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.MigrateParams(ctx)
+}
+
+// Migrate4to5 migrates from version 4 to 5, adding the denom allowances
+// param.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return m.MigrateParams(ctx)
+}
+
+// MigrateParams migrates params by setting new params to their default value
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	// Params added since the last migration are not yet in the store.
+	params := types.DefaultParams()
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
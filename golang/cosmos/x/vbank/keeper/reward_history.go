@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// rewardHistoryKeyPrefix prefixes the keys of the reward epochs, which are
+// followed by the big-endian start block of the epoch.
+const rewardHistoryKeyPrefix = "rewardHistory/"
+
+// rewardHistoryCountKey holds the number of reward epochs in the history, so
+// that pruning does not need to scan it.
+const rewardHistoryCountKey = "rewardHistoryCount"
+
+// rewardHistoryLength is the number of most recent reward epochs kept.
+const rewardHistoryLength = 100
+
+func (k Keeper) rewardHistoryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(rewardHistoryKeyPrefix))
+}
+
+func rewardEpochKey(startBlock int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(startBlock))
+}
+
+func (k Keeper) getRewardHistoryCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(rewardHistoryCountKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setRewardHistoryCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(rewardHistoryCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// startRewardEpoch records a new reward epoch in the history, pruning the
+// oldest epoch beyond rewardHistoryLength. Epochs which distribute nothing are
+// not recorded.
+func (k Keeper) startRewardEpoch(ctx sdk.Context, startBlock int64, rewardBlockAmount sdk.Coins) {
+	if rewardBlockAmount.IsZero() {
+		return
+	}
+
+	store := k.rewardHistoryStore(ctx)
+	key := rewardEpochKey(startBlock)
+	count := k.getRewardHistoryCount(ctx)
+	if !store.Has(key) {
+		count++
+	}
+	epoch := types.RewardEpoch{
+		StartBlock:        startBlock,
+		RewardBlockAmount: rewardBlockAmount,
+	}
+	store.Set(key, k.cdc.MustMarshal(&epoch))
+
+	if count > rewardHistoryLength {
+		iterator := store.Iterator(nil, nil)
+		oldest := iterator.Key()
+		iterator.Close()
+		store.Delete(oldest)
+		count--
+	}
+	k.setRewardHistoryCount(ctx, count)
+}
+
+// addRewardEpochDistributed adds the distributed coins to the total of the
+// reward epoch which started at startBlock, if it is still in the history.
+func (k Keeper) addRewardEpochDistributed(ctx sdk.Context, startBlock int64, distributed sdk.Coins) {
+	store := k.rewardHistoryStore(ctx)
+	bz := store.Get(rewardEpochKey(startBlock))
+	if bz == nil {
+		return
+	}
+	var epoch types.RewardEpoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+	epoch.Distributed = epoch.Distributed.Add(distributed...)
+	store.Set(rewardEpochKey(startBlock), k.cdc.MustMarshal(&epoch))
+}
+
+// GetRewardHistory returns the recent reward epochs, oldest first.
+func (k Keeper) GetRewardHistory(ctx sdk.Context) []types.RewardEpoch {
+	epochs := []types.RewardEpoch{}
+	iterator := k.rewardHistoryStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.RewardEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, epoch)
+	}
+	return epochs
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 )
 
 // minCoins returns the minimum of each denomination.
@@ -43,6 +46,43 @@ func mulCoins(a sdk.Coins, b sdk.Dec) sdk.Coins {
 	return sdk.NewCoins(coins...)
 }
 
+// splitCoins divides the coins among the weights, which must be positive.
+// Fractions are rounded down, and the last share receives the remainder, so
+// that the shares always add up to the coins.
+func splitCoins(coins sdk.Coins, weights []sdk.Dec) []sdk.Coins {
+	shares := make([]sdk.Coins, len(weights))
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+	remaining := coins
+	for i, weight := range weights {
+		if i == len(weights)-1 {
+			shares[i] = remaining
+			break
+		}
+		shares[i] = mulCoins(coins, weight.Quo(totalWeight))
+		remaining = remaining.Sub(shares[i]...)
+	}
+	return shares
+}
+
+// addRewardDestinationTotal adds the distributed coins to the destination's
+// total, keeping the totals sorted by destination.
+func addRewardDestinationTotal(totals []types.RewardDestinationTotal, destination string, coins sdk.Coins) []types.RewardDestinationTotal {
+	i := sort.Search(len(totals), func(i int) bool {
+		return totals[i].Destination >= destination
+	})
+	if i < len(totals) && totals[i].Destination == destination {
+		totals[i].Distributed = totals[i].Distributed.Add(coins...)
+		return totals
+	}
+	totals = append(totals, types.RewardDestinationTotal{})
+	copy(totals[i+1:], totals[i:])
+	totals[i] = types.RewardDestinationTotal{Destination: destination, Distributed: coins}
+	return totals
+}
+
 // DistributeRewards drives the rewards state machine.
 func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	// Distribute rewards.
@@ -60,6 +100,7 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 		state.LastRewardDistributionBlock = thisBlock
 		state.RewardBlockAmount = params.RewardRate(toDistribute, smoothingBlocks)
 		k.SetState(ctx, state)
+		k.startRewardEpoch(ctx, thisBlock, state.RewardBlockAmount)
 	}
 
 	if cycleIndex >= smoothingBlocks {
@@ -67,15 +108,46 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 		return nil
 	}
 
-	// We're currently within the smoothing period, send the amount to distribute.
+	// We're currently within the smoothing period, send the amount to distribute
+	// to each destination according to its weight. Each share is sent in its
+	// own cache context, so that a destination which cannot be paid neither
+	// stops the others nor leaves a partial send behind. Its share stays in the
+	// reward pool.
 	xfer := minCoins(state.RewardBlockAmount, state.RewardPool)
+	sent := sdk.NewCoins()
 	if !xfer.IsZero() {
-		if err := k.SendCoinsToRewardDistributor(ctx, xfer); err != nil {
-			return err
+		destinations := params.GetEffectiveRewardDestinations()
+		weights := make([]sdk.Dec, len(destinations))
+		for i, dest := range destinations {
+			weights[i] = dest.Weight
+		}
+		for i, share := range splitCoins(xfer, weights) {
+			if share.IsZero() {
+				continue
+			}
+			key := destinations[i].Key()
+			destCtx, writeDest := ctx.CacheContext()
+			if err := k.SendCoinsToRewardDestination(destCtx, destinations[i], share); err != nil {
+				ctx.Logger().Error("cannot send rewards", "destination", key, "amount", share, "err", err)
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardDestinationFailed{
+					Destination: key,
+					Amount:      share,
+					Error:       err.Error(),
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+			writeDest()
+			state.RewardDestinationTotals = addRewardDestinationTotal(state.RewardDestinationTotals, key, share)
+			sent = sent.Add(share...)
+		}
+		if !sent.IsZero() {
+			k.addRewardEpochDistributed(ctx, state.LastRewardDistributionBlock, sent)
 		}
 	}
 
-	state.RewardPool = state.RewardPool.Sub(xfer...)
+	state.RewardPool = state.RewardPool.Sub(sent...)
 	k.SetState(ctx, state)
 	return nil
 }
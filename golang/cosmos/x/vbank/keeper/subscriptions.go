@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// subscriptionKeyPrefix prefixes the keys of the balance subscriptions, which
+// are followed by the length-prefixed subscribed address and the subscribed
+// denom, or allDenomsSubscriptionKey.
+const subscriptionKeyPrefix = "subscription/"
+
+// allDenomsSubscriptionKey stands for the empty denom of a subscription to all
+// denoms, since store keys cannot be empty. It is not a valid denom.
+const allDenomsSubscriptionKey = "*"
+
+func subscriptionDenomKey(denom string) []byte {
+	if denom == "" {
+		return []byte(allDenomsSubscriptionKey)
+	}
+	return []byte(denom)
+}
+
+func (k Keeper) subscriptionStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(subscriptionKeyPrefix))
+}
+
+func (k Keeper) addressSubscriptionStore(ctx sdk.Context, addr sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(k.subscriptionStore(ctx), address.MustLengthPrefix(addr))
+}
+
+// Subscribe records that balance updates of addr for denom should be sent to
+// the VM. An empty denom subscribes to all denoms.
+func (k Keeper) Subscribe(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.addressSubscriptionStore(ctx, addr).Set(subscriptionDenomKey(denom), []byte{1})
+}
+
+// Unsubscribe removes a subscription added by Subscribe, if any.
+func (k Keeper) Unsubscribe(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.addressSubscriptionStore(ctx, addr).Delete(subscriptionDenomKey(denom))
+}
+
+// FilterSubscribedDenoms returns the coins of the given denoms to which addr
+// is subscribed.
+func (k Keeper) FilterSubscribedDenoms(ctx sdk.Context, addr sdk.AccAddress, denoms sdk.Coins) sdk.Coins {
+	store := k.addressSubscriptionStore(ctx, addr)
+	if store.Has([]byte(allDenomsSubscriptionKey)) {
+		return denoms
+	}
+	subscribed := sdk.Coins{}
+	for _, coin := range denoms {
+		if store.Has([]byte(coin.Denom)) {
+			subscribed = append(subscribed, coin)
+		}
+	}
+	return subscribed
+}
+
+// GetAllSubscriptions returns all the balance subscriptions, ordered by
+// address then denom.
+func (k Keeper) GetAllSubscriptions(ctx sdk.Context) []types.BalanceSubscription {
+	subscriptions := []types.BalanceSubscription{}
+	iterator := k.subscriptionStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		addrLen := int(key[0])
+		addr := sdk.AccAddress(key[1 : 1+addrLen])
+		denom := string(key[1+addrLen:])
+		if denom == allDenomsSubscriptionKey {
+			denom = ""
+		}
+		subscriptions = append(subscriptions, types.BalanceSubscription{
+			Address: addr.String(),
+			Denom:   denom,
+		})
+	}
+	return subscriptions
+}
@@ -96,7 +96,7 @@ func (AppModule) Name() string {
 	return ModuleName
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -155,16 +155,22 @@ NextEvent:
 		}
 	}
 
-	// Prune the addressToUpdate map to only include module accounts.  We prune
-	// only after recording and consolidating all account updates to minimize the
-	// number of account keeper queries.
+	// Prune the addressToUpdate map to only include module accounts and the
+	// subscribed denoms of other accounts.  We prune only after recording and
+	// consolidating all account updates to minimize the number of account
+	// keeper queries.
 	unfilteredAddresses := addressToUpdate
 	addressToUpdate = make(map[string]sdk.Coins, len(addressToUpdate))
 	for addr, denoms := range unfilteredAddresses {
 		accAddr, err := sdk.AccAddressFromBech32(addr)
-		if err == nil && am.keeper.IsModuleAccount(ctx, accAddr) {
+		if err != nil {
+			continue
+		}
+		if am.keeper.IsModuleAccount(ctx, accAddr) {
 			// Pass through the module account.
 			addressToUpdate[addr] = denoms
+		} else if subscribed := am.keeper.FilterSubscribedDenoms(ctx, accAddr, denoms); !subscribed.IsZero() {
+			addressToUpdate[addr] = subscribed
 		}
 	}
 
@@ -185,8 +191,8 @@ NextEvent:
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements the AppModule interface
@@ -209,6 +215,23 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	tx := &types.UnimplementedMsgServer{}
 	types.RegisterMsgServer(cfg.MsgServer(), tx)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	m := keeper.NewMigrator(am.keeper)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
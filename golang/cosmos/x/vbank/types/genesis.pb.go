@@ -29,6 +29,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// state is the current operation state.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// subscriptions are the balance subscriptions of non-module accounts.
+	Subscriptions []BalanceSubscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetSubscriptions() []BalanceSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.vbank.GenesisState")
 }
@@ -85,22 +94,24 @@ func init() {
 func init() { proto.RegisterFile("agoric/vbank/genesis.proto", fileDescriptor_8aaac686f3bede01) }
 
 var fileDescriptor_8aaac686f3bede01 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
+	// 270 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x4c, 0xcf, 0x2f,
 	0xca, 0x4c, 0xd6, 0x2f, 0x4b, 0x4a, 0xcc, 0xcb, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xc8, 0xe9, 0x81, 0xe5, 0xa4, 0x44, 0xd2,
 	0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x04, 0x8a, 0x7e, 0x30, 0x09,
-	0x91, 0x51, 0xaa, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc4,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x87, 0x6c, 0xbc, 0x5e, 0x00, 0x58, 0xce, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x4a,
-	0x21, 0x7d, 0x2e, 0xd6, 0x62, 0x90, 0x66, 0x09, 0x26, 0xb0, 0x16, 0x61, 0x54, 0x2d, 0x60, 0x73,
-	0xa1, 0x3a, 0x20, 0xea, 0xac, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3a, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0x47, 0x88, 0xcb, 0x21, 0x46, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0xa7, 0xe7, 0xe7,
-	0x24, 0xe6, 0xa5, 0xeb, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x57, 0x40, 0x3d, 0x55, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x95, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd8,
-	0x19, 0xc8, 0x31, 0x01, 0x00, 0x00,
+	0x91, 0x51, 0x3a, 0xc1, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x2f, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8,
+	0x88, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
+	0x44, 0x0f, 0xd9, 0x7c, 0xbd, 0x00, 0xb0, 0x9c, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50,
+	0x95, 0x42, 0xfa, 0x5c, 0xac, 0xc5, 0x20, 0xcd, 0x12, 0x4c, 0x60, 0x2d, 0xc2, 0xa8, 0x5a, 0xc0,
+	0xe6, 0x42, 0x75, 0x40, 0xd4, 0x09, 0xf9, 0x72, 0xf1, 0x16, 0x97, 0x26, 0x15, 0x27, 0x17, 0x65,
+	0x16, 0x94, 0x64, 0xe6, 0xe7, 0x15, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xa2, 0x6a,
+	0x74, 0x4a, 0xcc, 0x49, 0xcc, 0x4b, 0x4e, 0x0d, 0x46, 0x52, 0x09, 0x35, 0x06, 0x55, 0xb7, 0x15,
+	0xcb, 0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
+	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
+	0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x08, 0x09,
+	0x09, 0x88, 0x45, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0xfa, 0xc9,
+	0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x15, 0xd0, 0x40, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
+	0x03, 0x87, 0x92, 0x31, 0x60, 0x00, 0x18, 0xef, 0x6a, 0x0b, 0x81, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +134,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -167,6 +192,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +302,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, BalanceSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -14,6 +15,16 @@ var (
 	ParamStoreKeyRewardEpochDurationBlocks = []byte("reward_epoch_duration_blocks")
 	ParamStoreKeyRewardSmoothingBlocks     = []byte("reward_smoothing_blocks")
 	ParamStoreKeyPerEpochRewardFraction    = []byte("per_epoch_reward_fraction")
+	ParamStoreKeyRewardDestinations        = []byte("reward_destinations")
+	ParamStoreKeySettableMetadataDenoms    = []byte("settable_metadata_denoms")
+	ParamStoreKeyDenomAllowances           = []byte("denom_allowances")
+)
+
+// Reward destination kinds
+const (
+	RewardDestinationFeeCollector  = "fee_collector"
+	RewardDestinationCommunityPool = "community_pool"
+	RewardDestinationModuleAccount = "module_account"
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -27,7 +38,56 @@ func DefaultParams() Params {
 		RewardEpochDurationBlocks: 0,
 		RewardSmoothingBlocks:     1,
 		PerEpochRewardFraction:    sdk.OneDec(),
+		RewardDestinations:        []RewardDestination{},
+		SettableMetadataDenoms:    []string{},
+		DenomAllowances:           []DenomAllowance{},
+	}
+}
+
+// Key returns the identifier of the destination in the reward totals.
+func (rd RewardDestination) Key() string {
+	if rd.Kind == RewardDestinationModuleAccount {
+		return rd.Kind + ":" + rd.ModuleName
+	}
+	return rd.Kind
+}
+
+// GetEffectiveRewardDestinations returns the reward destinations, defaulting to just
+// the fee collector.
+func (p Params) GetEffectiveRewardDestinations() []RewardDestination {
+	if len(p.RewardDestinations) == 0 {
+		return []RewardDestination{{Kind: RewardDestinationFeeCollector, Weight: sdk.OneDec()}}
+	}
+	return p.RewardDestinations
+}
+
+// IsMetadataSettable returns whether the VM may set the metadata of denom.
+func (p Params) IsMetadataSettable(denom string) bool {
+	for _, settable := range p.SettableMetadataDenoms {
+		if settable == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDenomAllowance returns the allowance of denom. All operations are allowed
+// without limit if there are no denom allowances.
+func (p Params) GetDenomAllowance(denom string) (DenomAllowance, bool) {
+	if len(p.DenomAllowances) == 0 {
+		return DenomAllowance{Denom: denom, Give: true, Grab: true, GiveLimitPerBlock: sdk.ZeroInt()}, true
+	}
+	for _, allowance := range p.DenomAllowances {
+		if allowance.Denom == denom {
+			return allowance, true
+		}
 	}
+	return DenomAllowance{}, false
+}
+
+// HasGiveLimit returns whether the amount given per block is limited.
+func (da DenomAllowance) HasGiveLimit() bool {
+	return !da.GiveLimitPerBlock.IsNil() && da.GiveLimitPerBlock.IsPositive()
 }
 
 func (p Params) String() string {
@@ -73,6 +133,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardEpochDurationBlocks, &p.RewardEpochDurationBlocks, validateRewardEpochDurationBlocks),
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardSmoothingBlocks, &p.RewardSmoothingBlocks, validateRewardSmoothingBlocks),
 		paramtypes.NewParamSetPair(ParamStoreKeyPerEpochRewardFraction, &p.PerEpochRewardFraction, validatePerEpochRewardFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardDestinations, &p.RewardDestinations, validateRewardDestinations),
+		paramtypes.NewParamSetPair(ParamStoreKeySettableMetadataDenoms, &p.SettableMetadataDenoms, validateSettableMetadataDenoms),
+		paramtypes.NewParamSetPair(ParamStoreKeyDenomAllowances, &p.DenomAllowances, validateDenomAllowances),
 	}
 }
 
@@ -84,6 +147,15 @@ func (p Params) ValidateBasic() error {
 	if err := validatePerEpochRewardFraction(p.PerEpochRewardFraction); err != nil {
 		return err
 	}
+	if err := validateRewardDestinations(p.RewardDestinations); err != nil {
+		return err
+	}
+	if err := validateSettableMetadataDenoms(p.SettableMetadataDenoms); err != nil {
+		return err
+	}
+	if err := validateDenomAllowances(p.DenomAllowances); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -130,3 +202,89 @@ func validatePerEpochRewardFraction(i interface{}) error {
 
 	return nil
 }
+
+// rewardModuleAccounts are the module accounts which may be reward
+// destinations. Funding other module accounts directly, such as the staking
+// pools or the distribution module account, would break their accounting.
+var rewardModuleAccounts = map[string]bool{
+	ReservePoolName:   true,
+	ProvisionPoolName: true,
+	GiveawayPoolName:  true,
+}
+
+func validateRewardDestinations(i interface{}) error {
+	v, ok := i.([]RewardDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, rd := range v {
+		switch rd.Kind {
+		case RewardDestinationFeeCollector, RewardDestinationCommunityPool:
+			if rd.ModuleName != "" {
+				return fmt.Errorf("reward destination %s must not have a module name: %s", rd.Kind, rd.ModuleName)
+			}
+		case RewardDestinationModuleAccount:
+			if rd.ModuleName == "" {
+				return fmt.Errorf("reward destination %s must have a module name", rd.Kind)
+			}
+			if !rewardModuleAccounts[rd.ModuleName] {
+				return fmt.Errorf("reward destination %s must not be module account %s", rd.Kind, rd.ModuleName)
+			}
+		default:
+			return fmt.Errorf("unknown reward destination kind: %q", rd.Kind)
+		}
+		key := rd.Key()
+		if seen[key] {
+			return fmt.Errorf("duplicate reward destination: %s", key)
+		}
+		seen[key] = true
+		if rd.Weight.IsNil() || !rd.Weight.IsPositive() {
+			return fmt.Errorf("reward destination %s weight must be positive: %s", key, rd.Weight)
+		}
+	}
+
+	return nil
+}
+
+func validateSettableMetadataDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid settable metadata denom %s: %w", denom, err)
+		}
+		if strings.HasPrefix(denom, "ibc/") {
+			return fmt.Errorf("settable metadata denom must not be an IBC denom: %s", denom)
+		}
+	}
+
+	return nil
+}
+
+func validateDenomAllowances(i interface{}) error {
+	v, ok := i.([]DenomAllowance)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, allowance := range v {
+		if err := sdk.ValidateDenom(allowance.Denom); err != nil {
+			return fmt.Errorf("invalid denom allowance denom %s: %w", allowance.Denom, err)
+		}
+		if seen[allowance.Denom] {
+			return fmt.Errorf("duplicate denom allowance: %s", allowance.Denom)
+		}
+		seen[allowance.Denom] = true
+		if !allowance.GiveLimitPerBlock.IsNil() && allowance.GiveLimitPerBlock.IsNegative() {
+			return fmt.Errorf("denom allowance %s give limit per block must be nonnegative: %s", allowance.Denom, allowance.GiveLimitPerBlock)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -195,331 +197,2358 @@ func (m *QueryStateResponse) GetState() State {
 	return State{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
-	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
-	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+// QueryModulePoolsRequest is the request type for the Query/ModulePools RPC
+// method.
+type QueryModulePoolsRequest struct {
 }
 
-func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
-
-var fileDescriptor_f70e65583c8f2384 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0x4f, 0x5f, 0x3b, 0x18, 0x16, 0x9c, 0x80, 0xaa, 0x50, 0xb9, 0x25, 0x13, 0x0b,
-	0xb1, 0x54, 0x16, 0x56, 0x2a, 0x31, 0xb0, 0x41, 0xd9, 0xd8, 0xdc, 0x62, 0x99, 0xa8, 0xad, 0x6f,
-	0x1a, 0xbb, 0x88, 0xae, 0x3c, 0x01, 0x12, 0x2f, 0xd5, 0xb1, 0x12, 0x0b, 0x0b, 0x08, 0xb5, 0x3c,
-	0x08, 0xaa, 0x6d, 0xa4, 0x5a, 0xfc, 0x59, 0xa2, 0xe8, 0x9e, 0x73, 0x7f, 0xe7, 0x9e, 0x04, 0x37,
-	0xb8, 0x84, 0xaa, 0x18, 0xb0, 0xbb, 0x3e, 0x57, 0x43, 0x36, 0x99, 0x8a, 0x6a, 0x96, 0x97, 0x15,
-	0x18, 0x20, 0xdb, 0x4e, 0xc9, 0xad, 0x92, 0x26, 0x12, 0x24, 0x58, 0x81, 0xad, 0xdf, 0x9c, 0x27,
-	0x6d, 0x4a, 0x00, 0x39, 0x12, 0x8c, 0x97, 0x05, 0xe3, 0x4a, 0x81, 0xe1, 0xa6, 0x00, 0xa5, 0xbd,
-	0x1a, 0xb2, 0xed, 0xd3, 0x29, 0x59, 0x82, 0xc9, 0xe5, 0x3a, 0xea, 0x82, 0x57, 0x7c, 0xac, 0x7b,
-	0x62, 0x32, 0x15, 0xda, 0x64, 0xe7, 0x38, 0x0e, 0xa6, 0xba, 0x04, 0xa5, 0x05, 0xe9, 0xe0, 0x7a,
-	0x69, 0x27, 0x0d, 0xd4, 0x46, 0x87, 0x5b, 0x9d, 0x24, 0xdf, 0xbc, 0x2c, 0x77, 0xee, 0xee, 0xff,
-	0xf9, 0x5b, 0x2b, 0xea, 0x79, 0x67, 0x16, 0xe3, 0x1d, 0x8b, 0xba, 0x32, 0xdc, 0x88, 0x2f, 0xfe,
-	0x99, 0x4f, 0xf5, 0x43, 0x8f, 0x67, 0xb8, 0xa6, 0xd7, 0x03, 0x4f, 0x8f, 0x43, 0xba, 0xf5, 0x7a,
-	0xb8, 0xf3, 0x75, 0x5e, 0x11, 0xae, 0x59, 0x0e, 0x19, 0xe2, 0xba, 0x4b, 0x27, 0xed, 0x70, 0xeb,
-	0x7b, 0xb9, 0xf4, 0xe0, 0x0f, 0x87, 0xbb, 0x24, 0x6b, 0x3e, 0x3c, 0x7f, 0x3c, 0xfd, 0xdb, 0x23,
-	0x09, 0x0b, 0x3e, 0x9c, 0xab, 0x44, 0x24, 0xae, 0xd9, 0x63, 0x48, 0xeb, 0x07, 0xd2, 0x66, 0xcf,
-	0xb4, 0xfd, 0xbb, 0xc1, 0x27, 0xed, 0xdb, 0xa4, 0x5d, 0x12, 0x87, 0x49, 0xb6, 0x5f, 0xb7, 0x37,
-	0x5f, 0x52, 0xb4, 0x58, 0x52, 0xf4, 0xbe, 0xa4, 0xe8, 0x71, 0x45, 0xa3, 0xc5, 0x8a, 0x46, 0x2f,
-	0x2b, 0x1a, 0x5d, 0x9f, 0xc8, 0xc2, 0xdc, 0x4e, 0xfb, 0xf9, 0x00, 0xc6, 0xec, 0xd4, 0x2d, 0xba,
-	0xfd, 0x23, 0x7d, 0x33, 0x64, 0x12, 0x46, 0x5c, 0x49, 0x36, 0x00, 0x3d, 0x06, 0xcd, 0xee, 0x3d,
-	0xd3, 0xcc, 0x4a, 0xa1, 0xfb, 0x75, 0xfb, 0xdf, 0x8f, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x35, 0x34, 0x38, 0x6f, 0x02, 0x00, 0x00,
+func (m *QueryModulePoolsRequest) Reset()         { *m = QueryModulePoolsRequest{} }
+func (m *QueryModulePoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModulePoolsRequest) ProtoMessage()    {}
+func (*QueryModulePoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{4}
+}
+func (m *QueryModulePoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModulePoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModulePoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModulePoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModulePoolsRequest.Merge(m, src)
+}
+func (m *QueryModulePoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModulePoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModulePoolsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryModulePoolsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the vbank module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+// ModulePool is the balance of a vbank module pool.
+type ModulePool struct {
+	// name is the name of the module account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the bech32 address of the module account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// balances are the coins held by the module account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ModulePool) Reset()         { *m = ModulePool{} }
+func (m *ModulePool) String() string { return proto.CompactTextString(m) }
+func (*ModulePool) ProtoMessage()    {}
+func (*ModulePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ModulePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ModulePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModulePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
-	out := new(QueryStateResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ModulePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModulePool.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the vbank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+func (m *ModulePool) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *ModulePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModulePool.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
-}
+var xxx_messageInfo_ModulePool proto.InternalMessageInfo
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ModulePool) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *ModulePool) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).State(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/State",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+func (m *ModulePool) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.vbank.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/vbank/query.proto",
+// QueryModulePoolsResponse is the response type for the Query/ModulePools RPC
+// method.
+type QueryModulePoolsResponse struct {
+	// pools are the vbank module pools, sorted by name.
+	Pools []ModulePool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryModulePoolsResponse) Reset()         { *m = QueryModulePoolsResponse{} }
+func (m *QueryModulePoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModulePoolsResponse) ProtoMessage()    {}
+func (*QueryModulePoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{6}
+}
+func (m *QueryModulePoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModulePoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModulePoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryModulePoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModulePoolsResponse.Merge(m, src)
+}
+func (m *QueryModulePoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModulePoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModulePoolsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryModulePoolsResponse proto.InternalMessageInfo
+
+func (m *QueryModulePoolsResponse) GetPools() []ModulePool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryEpochPositionRequest is the request type for the Query/EpochPosition
+// RPC method.
+type QueryEpochPositionRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochPositionRequest) Reset()         { *m = QueryEpochPositionRequest{} }
+func (m *QueryEpochPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochPositionRequest) ProtoMessage()    {}
+func (*QueryEpochPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{7}
+}
+func (m *QueryEpochPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEpochPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochPositionRequest.Merge(m, src)
+}
+func (m *QueryEpochPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochPositionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEpochPositionRequest proto.InternalMessageInfo
+
+// QueryEpochPositionResponse is the response type for the Query/EpochPosition
+// RPC method.
+type QueryEpochPositionResponse struct {
+	// block_height is the height at which the position was computed.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// epoch_start_block is the block at which the current epoch started.
+	EpochStartBlock int64 `protobuf:"varint,2,opt,name=epoch_start_block,json=epochStartBlock,proto3" json:"epoch_start_block,omitempty"`
+	// next_epoch_block is the first block of the next epoch.
+	NextEpochBlock int64 `protobuf:"varint,3,opt,name=next_epoch_block,json=nextEpochBlock,proto3" json:"next_epoch_block,omitempty"`
+	// smoothing_blocks_left is the number of blocks after block_height in which
+	// rewards of the current epoch are still to be distributed.
+	SmoothingBlocksLeft int64 `protobuf:"varint,4,opt,name=smoothing_blocks_left,json=smoothingBlocksLeft,proto3" json:"smoothing_blocks_left,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEpochPositionResponse) Reset()         { *m = QueryEpochPositionResponse{} }
+func (m *QueryEpochPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochPositionResponse) ProtoMessage()    {}
+func (*QueryEpochPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{8}
+}
+func (m *QueryEpochPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEpochPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochPositionResponse.Merge(m, src)
+}
+func (m *QueryEpochPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochPositionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochPositionResponse proto.InternalMessageInfo
+
+func (m *QueryEpochPositionResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochPositionResponse) GetEpochStartBlock() int64 {
+	if m != nil {
+		return m.EpochStartBlock
+	}
+	return 0
 }
 
-func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEpochPositionResponse) GetNextEpochBlock() int64 {
+	if m != nil {
+		return m.NextEpochBlock
+	}
+	return 0
 }
 
-func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochPositionResponse) GetSmoothingBlocksLeft() int64 {
+	if m != nil {
+		return m.SmoothingBlocksLeft
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardHistoryRequest is the request type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryRequest struct {
 }
 
-func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardHistoryRequest) Reset()         { *m = QueryRewardHistoryRequest{} }
+func (m *QueryRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{9}
+}
+func (m *QueryRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryRequest.Merge(m, src)
+}
+func (m *QueryRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardHistoryRequest proto.InternalMessageInfo
+
+// QueryRewardHistoryResponse is the response type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryResponse struct {
+	// epochs are the recent reward epochs, oldest first.
+	Epochs []RewardEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryRewardHistoryResponse) Reset()         { *m = QueryRewardHistoryResponse{} }
+func (m *QueryRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{10}
+}
+func (m *QueryRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryResponse.Merge(m, src)
+}
+func (m *QueryRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryResponse) GetEpochs() []RewardEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// QueryDenomAllowlistRequest is the request type for the Query/DenomAllowlist
+// RPC method.
+type QueryDenomAllowlistRequest struct {
+}
+
+func (m *QueryDenomAllowlistRequest) Reset()         { *m = QueryDenomAllowlistRequest{} }
+func (m *QueryDenomAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAllowlistRequest) ProtoMessage()    {}
+func (*QueryDenomAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{11}
+}
+func (m *QueryDenomAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAllowlistRequest.Merge(m, src)
+}
+func (m *QueryDenomAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAllowlistRequest proto.InternalMessageInfo
+
+// QueryDenomAllowlistResponse is the response type for the
+// Query/DenomAllowlist RPC method.
+type QueryDenomAllowlistResponse struct {
+	// restricted is false if all denoms are allowed.
+	Restricted bool `protobuf:"varint,1,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// allowances are the allowed denoms and operations.
+	Allowances []DenomAllowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+	// given_this_block are the amounts given so far in the current block.
+	GivenThisBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=given_this_block,json=givenThisBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"given_this_block"`
+}
+
+func (m *QueryDenomAllowlistResponse) Reset()         { *m = QueryDenomAllowlistResponse{} }
+func (m *QueryDenomAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAllowlistResponse) ProtoMessage()    {}
+func (*QueryDenomAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{12}
+}
+func (m *QueryDenomAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAllowlistResponse.Merge(m, src)
+}
+func (m *QueryDenomAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryDenomAllowlistResponse) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *QueryDenomAllowlistResponse) GetAllowances() []DenomAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *QueryDenomAllowlistResponse) GetGivenThisBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GivenThisBlock
+	}
+	return nil
+}
+
+// QueryLastSequenceRequest is the request type for the Query/LastSequence RPC
+// method.
+type QueryLastSequenceRequest struct {
+}
+
+func (m *QueryLastSequenceRequest) Reset()         { *m = QueryLastSequenceRequest{} }
+func (m *QueryLastSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastSequenceRequest) ProtoMessage()    {}
+func (*QueryLastSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{13}
+}
+func (m *QueryLastSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastSequenceRequest.Merge(m, src)
+}
+func (m *QueryLastSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastSequenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastSequenceRequest proto.InternalMessageInfo
+
+// QueryLastSequenceResponse is the response type for the Query/LastSequence
+// RPC method.
+type QueryLastSequenceResponse struct {
+	// last_sequence is the nonce of the last balance update sent to the VM.
+	LastSequence uint64 `protobuf:"varint,1,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+}
+
+func (m *QueryLastSequenceResponse) Reset()         { *m = QueryLastSequenceResponse{} }
+func (m *QueryLastSequenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastSequenceResponse) ProtoMessage()    {}
+func (*QueryLastSequenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{14}
+}
+func (m *QueryLastSequenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastSequenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastSequenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastSequenceResponse.Merge(m, src)
+}
+func (m *QueryLastSequenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastSequenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastSequenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastSequenceResponse proto.InternalMessageInfo
+
+func (m *QueryLastSequenceResponse) GetLastSequence() uint64 {
+	if m != nil {
+		return m.LastSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
+	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
+	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+	proto.RegisterType((*QueryModulePoolsRequest)(nil), "agoric.vbank.QueryModulePoolsRequest")
+	proto.RegisterType((*ModulePool)(nil), "agoric.vbank.ModulePool")
+	proto.RegisterType((*QueryModulePoolsResponse)(nil), "agoric.vbank.QueryModulePoolsResponse")
+	proto.RegisterType((*QueryEpochPositionRequest)(nil), "agoric.vbank.QueryEpochPositionRequest")
+	proto.RegisterType((*QueryEpochPositionResponse)(nil), "agoric.vbank.QueryEpochPositionResponse")
+	proto.RegisterType((*QueryRewardHistoryRequest)(nil), "agoric.vbank.QueryRewardHistoryRequest")
+	proto.RegisterType((*QueryRewardHistoryResponse)(nil), "agoric.vbank.QueryRewardHistoryResponse")
+	proto.RegisterType((*QueryDenomAllowlistRequest)(nil), "agoric.vbank.QueryDenomAllowlistRequest")
+	proto.RegisterType((*QueryDenomAllowlistResponse)(nil), "agoric.vbank.QueryDenomAllowlistResponse")
+	proto.RegisterType((*QueryLastSequenceRequest)(nil), "agoric.vbank.QueryLastSequenceRequest")
+	proto.RegisterType((*QueryLastSequenceResponse)(nil), "agoric.vbank.QueryLastSequenceResponse")
+}
+
+func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
+
+var fileDescriptor_f70e65583c8f2384 = []byte{
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xb3, 0x49, 0x5a, 0x5e, 0xd2, 0xd0, 0xce, 0xa6, 0xe0, 0x75, 0xb6, 0x4e, 0xea, 0x52,
+	0xba, 0x20, 0x61, 0xd3, 0x05, 0x09, 0x8e, 0x74, 0xa1, 0x52, 0x91, 0x8a, 0x14, 0x1c, 0xb8, 0x70,
+	0xb1, 0x66, 0xbd, 0x53, 0xaf, 0x15, 0xaf, 0xc7, 0xf5, 0xcc, 0xa6, 0xcd, 0x0d, 0x21, 0x0e, 0x1c,
+	0x7a, 0x40, 0xe2, 0x27, 0x70, 0xe3, 0x97, 0x94, 0x5b, 0x25, 0x2e, 0x9c, 0x00, 0x25, 0xfc, 0x08,
+	0x8e, 0xc8, 0x6f, 0xc6, 0x1b, 0x8f, 0x62, 0xba, 0x1c, 0x7a, 0x49, 0x36, 0xef, 0x7d, 0xf3, 0xbd,
+	0x6f, 0xbe, 0xcc, 0xfb, 0xb4, 0x60, 0xd3, 0x84, 0x97, 0x69, 0x1c, 0x1c, 0x8f, 0x69, 0x7e, 0x14,
+	0x3c, 0x9e, 0xb3, 0xf2, 0xc4, 0x2f, 0x4a, 0x2e, 0x39, 0xd9, 0x52, 0x1d, 0x1f, 0x3b, 0xce, 0x4e,
+	0xc2, 0x13, 0x8e, 0x8d, 0xa0, 0xfa, 0xa4, 0x30, 0x4e, 0x3f, 0xe1, 0x3c, 0xc9, 0x58, 0x40, 0x8b,
+	0x34, 0xa0, 0x79, 0xce, 0x25, 0x95, 0x29, 0xcf, 0x85, 0xee, 0x9a, 0xdc, 0xf8, 0x53, 0x77, 0xdc,
+	0x98, 0x8b, 0x19, 0x17, 0xc1, 0x98, 0x0a, 0x16, 0x1c, 0xdf, 0x1d, 0x33, 0x49, 0xef, 0x06, 0x31,
+	0x4f, 0x73, 0xd5, 0xf7, 0x76, 0x80, 0x7c, 0x59, 0x49, 0x39, 0xa0, 0x25, 0x9d, 0x89, 0x90, 0x3d,
+	0x9e, 0x33, 0x21, 0xbd, 0xcf, 0xa1, 0x6b, 0x54, 0x45, 0xc1, 0x73, 0xc1, 0xc8, 0x10, 0x36, 0x0a,
+	0xac, 0xd8, 0xd6, 0xbe, 0x35, 0xd8, 0x1c, 0xee, 0xf8, 0x4d, 0xe5, 0xbe, 0x42, 0x8f, 0xd6, 0x9e,
+	0xff, 0xb1, 0xb7, 0x12, 0x6a, 0xa4, 0xd7, 0x85, 0x6b, 0x48, 0x75, 0x28, 0xa9, 0x64, 0x35, 0xff,
+	0x7d, 0x20, 0xcd, 0xa2, 0xa6, 0x0f, 0x60, 0x5d, 0x54, 0x05, 0xcd, 0xde, 0x35, 0xd9, 0x11, 0xab,
+	0xc9, 0x15, 0xce, 0xeb, 0xc1, 0x9b, 0x48, 0xf3, 0x05, 0x9f, 0xcc, 0x33, 0x76, 0xc0, 0x79, 0xb6,
+	0xb8, 0xc1, 0xcf, 0x16, 0xc0, 0x79, 0x99, 0x10, 0x58, 0xcb, 0xe9, 0x4c, 0x31, 0xbf, 0x16, 0xe2,
+	0x67, 0x62, 0xc3, 0x25, 0x3a, 0x99, 0x94, 0x4c, 0x08, 0x7b, 0x15, 0xcb, 0xf5, 0x9f, 0x24, 0x81,
+	0xcb, 0x63, 0x9a, 0xd1, 0x3c, 0x66, 0xc2, 0xee, 0xec, 0x77, 0x06, 0x9b, 0xc3, 0x9e, 0xaf, 0x7c,
+	0xf4, 0x2b, 0x1f, 0x7d, 0xed, 0xa3, 0xff, 0x29, 0x4f, 0xf3, 0xd1, 0xfb, 0x95, 0xa2, 0x5f, 0xfe,
+	0xdc, 0x1b, 0x24, 0xa9, 0x9c, 0xce, 0xc7, 0x7e, 0xcc, 0x67, 0x81, 0x36, 0x5d, 0xfd, 0x7a, 0x4f,
+	0x4c, 0x8e, 0x02, 0x79, 0x52, 0x30, 0x81, 0x07, 0x44, 0xb8, 0x20, 0xf7, 0x0e, 0xc0, 0xbe, 0x78,
+	0x01, 0xed, 0xc6, 0x87, 0xb0, 0x5e, 0x54, 0x05, 0xdb, 0x42, 0x05, 0xb6, 0xe9, 0xc6, 0xf9, 0x89,
+	0xda, 0x12, 0x04, 0x7b, 0xbb, 0xd0, 0x43, 0xc6, 0xfb, 0x05, 0x8f, 0xa7, 0x07, 0x5c, 0xa4, 0xd5,
+	0x33, 0xa9, 0x4d, 0xf9, 0xd5, 0x02, 0xa7, 0xad, 0xab, 0x27, 0xde, 0x84, 0xad, 0x71, 0xc6, 0xe3,
+	0xa3, 0x68, 0xca, 0xd2, 0x64, 0x2a, 0xd1, 0xac, 0x4e, 0xb8, 0x89, 0xb5, 0x07, 0x58, 0x22, 0xef,
+	0xc2, 0x35, 0x56, 0x9d, 0x8d, 0x84, 0xa4, 0xa5, 0x8c, 0xb0, 0x85, 0xee, 0x75, 0xc2, 0xd7, 0xb1,
+	0x71, 0x58, 0xd5, 0x47, 0x55, 0x99, 0x0c, 0xe0, 0x6a, 0xce, 0x9e, 0xca, 0x48, 0x1d, 0x50, 0xd0,
+	0x0e, 0x42, 0xb7, 0xab, 0x3a, 0x6a, 0x50, 0xc8, 0x21, 0x5c, 0x17, 0x33, 0xce, 0xe5, 0x34, 0xcd,
+	0x13, 0x05, 0x14, 0x51, 0xc6, 0x1e, 0x49, 0x7b, 0x0d, 0xe1, 0xdd, 0x45, 0x13, 0xe1, 0xe2, 0x21,
+	0x7b, 0x24, 0x17, 0x17, 0x0d, 0xd9, 0x13, 0x5a, 0x4e, 0x1e, 0xa4, 0x42, 0xf2, 0xf2, 0xa4, 0xbe,
+	0xe8, 0xd7, 0xe0, 0xb4, 0x35, 0xf5, 0x3d, 0x3f, 0x82, 0x0d, 0xd4, 0x54, 0x5b, 0xdb, 0x33, 0xad,
+	0x55, 0x87, 0x94, 0x3c, 0xfd, 0x96, 0x15, 0xdc, 0xeb, 0x6b, 0xda, 0xcf, 0x58, 0xce, 0x67, 0xf7,
+	0xb2, 0x8c, 0x3f, 0xc9, 0x52, 0x21, 0xeb, 0xa1, 0xff, 0x58, 0xb0, 0xdb, 0xda, 0xd6, 0x63, 0x5d,
+	0x80, 0x92, 0x09, 0x59, 0xa6, 0xb1, 0x64, 0x13, 0x34, 0xf7, 0x72, 0xd8, 0xa8, 0x90, 0x11, 0x00,
+	0xad, 0x0e, 0xa9, 0x77, 0xb7, 0x8a, 0xd2, 0xfa, 0xa6, 0xb4, 0x73, 0xe6, 0x0a, 0xa4, 0xd5, 0x35,
+	0x4e, 0x91, 0x39, 0x5c, 0x4d, 0xd2, 0x63, 0x96, 0x47, 0x72, 0x9a, 0x8a, 0x85, 0xe7, 0xaf, 0xfc,
+	0x05, 0x6f, 0xe3, 0x90, 0xaf, 0xa6, 0xa9, 0xc0, 0xff, 0x88, 0xe7, 0xe8, 0x77, 0xfc, 0x90, 0x0a,
+	0x79, 0x58, 0xd9, 0x91, 0xc7, 0x8b, 0x5d, 0xff, 0x04, 0x7a, 0x2d, 0x3d, 0xed, 0xc9, 0x2d, 0xb8,
+	0x92, 0x51, 0x21, 0x23, 0xa1, 0x1b, 0x68, 0xcb, 0x5a, 0xb8, 0x95, 0x35, 0xc0, 0xc3, 0x67, 0x97,
+	0x60, 0x1d, 0x29, 0xc8, 0x11, 0x6c, 0xa8, 0x90, 0x21, 0xfb, 0xa6, 0x31, 0x17, 0x33, 0xcc, 0xb9,
+	0xf9, 0x12, 0x84, 0x9a, 0xee, 0xf5, 0xbf, 0xfb, 0xed, 0xef, 0x9f, 0x56, 0xdf, 0x20, 0x3b, 0x81,
+	0x91, 0x9f, 0x2a, 0xb9, 0x48, 0x02, 0xeb, 0x98, 0x39, 0x64, 0xaf, 0x85, 0xa9, 0x19, 0x67, 0xce,
+	0xfe, 0x7f, 0x03, 0xf4, 0xa4, 0x5d, 0x9c, 0x74, 0x9d, 0x74, 0xcd, 0x49, 0x18, 0x63, 0xe4, 0x5b,
+	0x0b, 0x36, 0x1b, 0x09, 0x40, 0x6e, 0xb7, 0xd0, 0x5d, 0x8c, 0x38, 0xe7, 0xed, 0x65, 0x30, 0x3d,
+	0xdb, 0xc3, 0xd9, 0x7d, 0xe2, 0x98, 0xb3, 0x67, 0x08, 0x8d, 0x30, 0x36, 0xc8, 0x0f, 0x16, 0x5c,
+	0x31, 0x42, 0x81, 0xdc, 0x69, 0x61, 0x6f, 0x0b, 0x15, 0x67, 0xb0, 0x1c, 0xa8, 0x85, 0xbc, 0x85,
+	0x42, 0x5c, 0xd2, 0x37, 0x85, 0xa8, 0x7c, 0x28, 0xea, 0xc1, 0x95, 0x14, 0x63, 0x6f, 0x5b, 0xa5,
+	0xb4, 0xad, 0xbd, 0x33, 0x58, 0x0e, 0x7c, 0xb9, 0x94, 0x12, 0xc1, 0xd1, 0x54, 0x0f, 0x7e, 0x66,
+	0xc1, 0xb6, 0xb9, 0xcc, 0xa4, 0x6d, 0x44, 0x6b, 0x1c, 0x38, 0xef, 0xfc, 0x0f, 0xa4, 0x56, 0x73,
+	0x1b, 0xd5, 0xec, 0x91, 0x1b, 0xa6, 0x9a, 0x49, 0x85, 0x8e, 0xe8, 0x62, 0xf6, 0xf7, 0x16, 0x6c,
+	0x35, 0xb7, 0x88, 0xb4, 0xbd, 0x80, 0x96, 0x15, 0x74, 0xee, 0x2c, 0xc5, 0x69, 0x21, 0xb7, 0x50,
+	0xc8, 0x0d, 0xb2, 0x6b, 0x0a, 0x31, 0x56, 0x74, 0x14, 0x3e, 0x3f, 0x75, 0xad, 0x17, 0xa7, 0xae,
+	0xf5, 0xd7, 0xa9, 0x6b, 0xfd, 0x78, 0xe6, 0xae, 0xbc, 0x38, 0x73, 0x57, 0x7e, 0x3f, 0x73, 0x57,
+	0xbe, 0xf9, 0xb8, 0x11, 0x20, 0xf7, 0x14, 0x81, 0xe2, 0xc1, 0x00, 0x49, 0x78, 0x46, 0xf3, 0xa4,
+	0x4e, 0x96, 0xa7, 0x9a, 0x1b, 0x63, 0x65, 0xbc, 0x81, 0xdf, 0x46, 0x3e, 0xf8, 0x77, 0x00, 0xa9,
+	0xff, 0x2f, 0x67, 0x25, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the vbank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// ModulePools queries the balances of the vbank module pools.
+	ModulePools(ctx context.Context, in *QueryModulePoolsRequest, opts ...grpc.CallOption) (*QueryModulePoolsResponse, error)
+	// EpochPosition queries the position of the current block in the reward
+	// epoch.
+	EpochPosition(ctx context.Context, in *QueryEpochPositionRequest, opts ...grpc.CallOption) (*QueryEpochPositionResponse, error)
+	// RewardHistory queries the rewards distributed in recent epochs.
+	RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error)
+	// DenomAllowlist queries the denoms which VBANK_GIVE and VBANK_GRAB may
+	// touch.
+	DenomAllowlist(ctx context.Context, in *QueryDenomAllowlistRequest, opts ...grpc.CallOption) (*QueryDenomAllowlistResponse, error)
+	// LastSequence queries the nonce of the last balance update sent to the VM.
+	LastSequence(ctx context.Context, in *QueryLastSequenceRequest, opts ...grpc.CallOption) (*QueryLastSequenceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModulePools(ctx context.Context, in *QueryModulePoolsRequest, opts ...grpc.CallOption) (*QueryModulePoolsResponse, error) {
+	out := new(QueryModulePoolsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/ModulePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochPosition(ctx context.Context, in *QueryEpochPositionRequest, opts ...grpc.CallOption) (*QueryEpochPositionResponse, error) {
+	out := new(QueryEpochPositionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/EpochPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error) {
+	out := new(QueryRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAllowlist(ctx context.Context, in *QueryDenomAllowlistRequest, opts ...grpc.CallOption) (*QueryDenomAllowlistResponse, error) {
+	out := new(QueryDenomAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/DenomAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastSequence(ctx context.Context, in *QueryLastSequenceRequest, opts ...grpc.CallOption) (*QueryLastSequenceResponse, error) {
+	out := new(QueryLastSequenceResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/LastSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the vbank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// ModulePools queries the balances of the vbank module pools.
+	ModulePools(context.Context, *QueryModulePoolsRequest) (*QueryModulePoolsResponse, error)
+	// EpochPosition queries the position of the current block in the reward
+	// epoch.
+	EpochPosition(context.Context, *QueryEpochPositionRequest) (*QueryEpochPositionResponse, error)
+	// RewardHistory queries the rewards distributed in recent epochs.
+	RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error)
+	// DenomAllowlist queries the denoms which VBANK_GIVE and VBANK_GRAB may
+	// touch.
+	DenomAllowlist(context.Context, *QueryDenomAllowlistRequest) (*QueryDenomAllowlistResponse, error)
+	// LastSequence queries the nonce of the last balance update sent to the VM.
+	LastSequence(context.Context, *QueryLastSequenceRequest) (*QueryLastSequenceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) ModulePools(ctx context.Context, req *QueryModulePoolsRequest) (*QueryModulePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModulePools not implemented")
+}
+func (*UnimplementedQueryServer) EpochPosition(ctx context.Context, req *QueryEpochPositionRequest) (*QueryEpochPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochPosition not implemented")
+}
+func (*UnimplementedQueryServer) RewardHistory(ctx context.Context, req *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
+func (*UnimplementedQueryServer) DenomAllowlist(ctx context.Context, req *QueryDenomAllowlistRequest) (*QueryDenomAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) LastSequence(ctx context.Context, req *QueryLastSequenceRequest) (*QueryLastSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSequence not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModulePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModulePoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModulePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/ModulePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModulePools(ctx, req.(*QueryModulePoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/EpochPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochPosition(ctx, req.(*QueryEpochPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*QueryRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/DenomAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAllowlist(ctx, req.(*QueryDenomAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/LastSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSequence(ctx, req.(*QueryLastSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vbank.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "ModulePools",
+			Handler:    _Query_ModulePools_Handler,
+		},
+		{
+			MethodName: "EpochPosition",
+			Handler:    _Query_EpochPosition_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
+		{
+			MethodName: "DenomAllowlist",
+			Handler:    _Query_DenomAllowlist_Handler,
+		},
+		{
+			MethodName: "LastSequence",
+			Handler:    _Query_LastSequence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vbank/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModulePoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModulePoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModulePoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModulePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModulePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModulePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModulePoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModulePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModulePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SmoothingBlocksLeft != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SmoothingBlocksLeft))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextEpochBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochStartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GivenThisBlock) > 0 {
+		for iNdEx := len(m.GivenThisBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GivenThisBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModulePoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModulePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModulePoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.EpochStartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartBlock))
+	}
+	if m.NextEpochBlock != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochBlock))
+	}
+	if m.SmoothingBlocksLeft != 0 {
+		n += 1 + sovQuery(uint64(m.SmoothingBlocksLeft))
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restricted {
+		n += 2
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GivenThisBlock) > 0 {
+		for _, e := range m.GivenThisBlock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSequence != 0 {
+		n += 1 + sovQuery(uint64(m.LastSequence))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModulePoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModulePoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModulePoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModulePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModulePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModulePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModulePoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModulePoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModulePoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, ModulePool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartBlock", wireType)
+			}
+			m.EpochStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochBlock", wireType)
+			}
+			m.NextEpochBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothingBlocksLeft", wireType)
+			}
+			m.SmoothingBlocksLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmoothingBlocksLeft |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -542,10 +2571,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -569,7 +2598,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,15 +2621,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,7 +2656,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, RewardEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -652,7 +2682,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -675,10 +2705,10 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -702,7 +2732,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,15 +2755,35 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -760,7 +2810,42 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, DenomAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GivenThisBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GivenThisBlock = append(m.GivenThisBlock, types.Coin{})
+			if err := m.GivenThisBlock[len(m.GivenThisBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -785,6 +2870,125 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLastSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+			}
+			m.LastSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39,6 +39,15 @@ type Params struct {
 	// an epoch's rewards.  If zero, use the same value as
 	// reward_epoch_duration_blocks.
 	RewardSmoothingBlocks int64 `protobuf:"varint,3,opt,name=reward_smoothing_blocks,json=rewardSmoothingBlocks,proto3" json:"reward_smoothing_blocks,omitempty" yaml:"reward_smoothing_blocks"`
+	// reward_destinations are the weighted destinations of the distributed
+	// rewards.  If empty, all the rewards are sent to the fee collector.
+	RewardDestinations []RewardDestination `protobuf:"bytes,4,rep,name=reward_destinations,json=rewardDestinations,proto3" json:"reward_destinations" yaml:"reward_destinations"`
+	// settable_metadata_denoms are the denoms whose bank metadata the VM may
+	// set with VBANK_SET_DENOM_METADATA.
+	SettableMetadataDenoms []string `protobuf:"bytes,5,rep,name=settable_metadata_denoms,json=settableMetadataDenoms,proto3" json:"settable_metadata_denoms,omitempty" yaml:"settable_metadata_denoms"`
+	// denom_allowances restrict the denoms which VBANK_GIVE and VBANK_GRAB may
+	// touch.  If empty, all denoms are allowed.
+	DenomAllowances []DenomAllowance `protobuf:"bytes,6,rep,name=denom_allowances,json=denomAllowances,proto3" json:"denom_allowances" yaml:"denom_allowances"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -87,6 +96,210 @@ func (m *Params) GetRewardSmoothingBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetRewardDestinations() []RewardDestination {
+	if m != nil {
+		return m.RewardDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetSettableMetadataDenoms() []string {
+	if m != nil {
+		return m.SettableMetadataDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetDenomAllowances() []DenomAllowance {
+	if m != nil {
+		return m.DenomAllowances
+	}
+	return nil
+}
+
+// The operations of the vbank port allowed on a denom.
+type DenomAllowance struct {
+	// denom is the allowed denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// give allows VBANK_GIVE of the denom.
+	Give bool `protobuf:"varint,2,opt,name=give,proto3" json:"give,omitempty" yaml:"give"`
+	// grab allows VBANK_GRAB of the denom.
+	Grab bool `protobuf:"varint,3,opt,name=grab,proto3" json:"grab,omitempty" yaml:"grab"`
+	// give_limit_per_block is the maximum amount of the denom given in a block,
+	// or zero for no limit.
+	GiveLimitPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=give_limit_per_block,json=giveLimitPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"give_limit_per_block" yaml:"give_limit_per_block"`
+}
+
+func (m *DenomAllowance) Reset()         { *m = DenomAllowance{} }
+func (m *DenomAllowance) String() string { return proto.CompactTextString(m) }
+func (*DenomAllowance) ProtoMessage()    {}
+func (*DenomAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+}
+func (m *DenomAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAllowance.Merge(m, src)
+}
+func (m *DenomAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAllowance proto.InternalMessageInfo
+
+func (m *DenomAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomAllowance) GetGive() bool {
+	if m != nil {
+		return m.Give
+	}
+	return false
+}
+
+func (m *DenomAllowance) GetGrab() bool {
+	if m != nil {
+		return m.Grab
+	}
+	return false
+}
+
+// A destination of distributed rewards, receiving its weight's share of each
+// block's reward amount.
+type RewardDestination struct {
+	// kind is one of "fee_collector", "community_pool", or "module_account".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty" yaml:"kind"`
+	// module_name is the name of the module account receiving the rewards if
+	// kind is "module_account", and otherwise empty.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	// weight is the positive weight of this destination relative to the sum of
+	// the weights of all the destinations.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *RewardDestination) Reset()         { *m = RewardDestination{} }
+func (m *RewardDestination) String() string { return proto.CompactTextString(m) }
+func (*RewardDestination) ProtoMessage()    {}
+func (*RewardDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{2}
+}
+func (m *RewardDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDestination.Merge(m, src)
+}
+func (m *RewardDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDestination proto.InternalMessageInfo
+
+func (m *RewardDestination) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RewardDestination) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// The total rewards distributed to a destination.
+type RewardDestinationTotal struct {
+	// destination identifies the destination, as returned by
+	// RewardDestination.Key().
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// distributed is the sum of all rewards sent to the destination.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed" yaml:"distributed"`
+}
+
+func (m *RewardDestinationTotal) Reset()         { *m = RewardDestinationTotal{} }
+func (m *RewardDestinationTotal) String() string { return proto.CompactTextString(m) }
+func (*RewardDestinationTotal) ProtoMessage()    {}
+func (*RewardDestinationTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{3}
+}
+func (m *RewardDestinationTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDestinationTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDestinationTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardDestinationTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDestinationTotal.Merge(m, src)
+}
+func (m *RewardDestinationTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDestinationTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDestinationTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDestinationTotal proto.InternalMessageInfo
+
+func (m *RewardDestinationTotal) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *RewardDestinationTotal) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
 // The current state of the module.
 type State struct {
 	// rewardPool is the current balance of rewards in the module account.
@@ -99,13 +312,16 @@ type State struct {
 	// last_sequence is a sequence number for communicating with the VM.
 	LastSequence                uint64 `protobuf:"varint,3,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty" yaml:"last_sequence"`
 	LastRewardDistributionBlock int64  `protobuf:"varint,4,opt,name=last_reward_distribution_block,json=lastRewardDistributionBlock,proto3" json:"last_reward_distribution_block,omitempty" yaml:"last_reward_distribution_block"`
+	// reward_destination_totals are the total rewards distributed to each
+	// destination, sorted by destination.
+	RewardDestinationTotals []RewardDestinationTotal `protobuf:"bytes,5,rep,name=reward_destination_totals,json=rewardDestinationTotals,proto3" json:"reward_destination_totals" yaml:"reward_destination_totals"`
 }
 
 func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+	return fileDescriptor_5e89b3b9e5e671b4, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,50 +378,343 @@ func (m *State) GetLastRewardDistributionBlock() int64 {
 	return 0
 }
 
+func (m *State) GetRewardDestinationTotals() []RewardDestinationTotal {
+	if m != nil {
+		return m.RewardDestinationTotals
+	}
+	return nil
+}
+
+// A subscription to the balance updates of an account which is not a module
+// account.  Balance changes of subscribed accounts are reported to the VM
+// like those of module accounts.
+type BalanceSubscription struct {
+	// address is the bech32 address of the subscribed account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// denom is the subscribed denom, or empty for all denoms.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *BalanceSubscription) Reset()         { *m = BalanceSubscription{} }
+func (m *BalanceSubscription) String() string { return proto.CompactTextString(m) }
+func (*BalanceSubscription) ProtoMessage()    {}
+func (*BalanceSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{5}
+}
+func (m *BalanceSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSubscription.Merge(m, src)
+}
+func (m *BalanceSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSubscription proto.InternalMessageInfo
+
+func (m *BalanceSubscription) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceSubscription) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// The rewards of a reward epoch, kept in a bounded history.
+type RewardEpoch struct {
+	// start_block is the block at which the epoch started.
+	StartBlock int64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty" yaml:"start_block"`
+	// reward_block_amount is the per-block reward amount of the epoch.
+	RewardBlockAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_block_amount,json=rewardBlockAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_block_amount" yaml:"reward_block_amount"`
+	// distributed is the total of the rewards distributed during the epoch.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed" yaml:"distributed"`
+}
+
+func (m *RewardEpoch) Reset()         { *m = RewardEpoch{} }
+func (m *RewardEpoch) String() string { return proto.CompactTextString(m) }
+func (*RewardEpoch) ProtoMessage()    {}
+func (*RewardEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{6}
+}
+func (m *RewardEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEpoch.Merge(m, src)
+}
+func (m *RewardEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardEpoch proto.InternalMessageInfo
+
+func (m *RewardEpoch) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *RewardEpoch) GetRewardBlockAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardBlockAmount
+	}
+	return nil
+}
+
+func (m *RewardEpoch) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+// The coins given through the vbank port in a block, limited by the
+// give_limit_per_block of the denom allowances.
+type GiveBlockUsage struct {
+	// block_height is the block in which the coins were given.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// given are the coins given so far in the block.
+	Given github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=given,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"given" yaml:"given"`
+}
+
+func (m *GiveBlockUsage) Reset()         { *m = GiveBlockUsage{} }
+func (m *GiveBlockUsage) String() string { return proto.CompactTextString(m) }
+func (*GiveBlockUsage) ProtoMessage()    {}
+func (*GiveBlockUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{7}
+}
+func (m *GiveBlockUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GiveBlockUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GiveBlockUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GiveBlockUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GiveBlockUsage.Merge(m, src)
+}
+func (m *GiveBlockUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GiveBlockUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GiveBlockUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GiveBlockUsage proto.InternalMessageInfo
+
+func (m *GiveBlockUsage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GiveBlockUsage) GetGiven() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Given
+	}
+	return nil
+}
+
+// EventRewardDestinationFailed is emitted when the share of the rewards of a
+// reward destination cannot be sent to it. The share stays in the reward pool.
+type EventRewardDestinationFailed struct {
+	// destination is the key of the reward destination, as in
+	// RewardDestinationTotal.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// amount is the share which was not sent.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// error describes the failure.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventRewardDestinationFailed) Reset()         { *m = EventRewardDestinationFailed{} }
+func (m *EventRewardDestinationFailed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardDestinationFailed) ProtoMessage()    {}
+func (*EventRewardDestinationFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{8}
+}
+func (m *EventRewardDestinationFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardDestinationFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardDestinationFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardDestinationFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardDestinationFailed.Merge(m, src)
+}
+func (m *EventRewardDestinationFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardDestinationFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardDestinationFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardDestinationFailed proto.InternalMessageInfo
+
+func (m *EventRewardDestinationFailed) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *EventRewardDestinationFailed) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventRewardDestinationFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "agoric.vbank.Params")
+	proto.RegisterType((*DenomAllowance)(nil), "agoric.vbank.DenomAllowance")
+	proto.RegisterType((*RewardDestination)(nil), "agoric.vbank.RewardDestination")
+	proto.RegisterType((*RewardDestinationTotal)(nil), "agoric.vbank.RewardDestinationTotal")
 	proto.RegisterType((*State)(nil), "agoric.vbank.State")
+	proto.RegisterType((*BalanceSubscription)(nil), "agoric.vbank.BalanceSubscription")
+	proto.RegisterType((*RewardEpoch)(nil), "agoric.vbank.RewardEpoch")
+	proto.RegisterType((*GiveBlockUsage)(nil), "agoric.vbank.GiveBlockUsage")
+	proto.RegisterType((*EventRewardDestinationFailed)(nil), "agoric.vbank.EventRewardDestinationFailed")
 }
 
 func init() { proto.RegisterFile("agoric/vbank/vbank.proto", fileDescriptor_5e89b3b9e5e671b4) }
 
 var fileDescriptor_5e89b3b9e5e671b4 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x49, 0xa8, 0xe0, 0x5a, 0x06, 0x4c, 0x01, 0x27, 0x20, 0x3b, 0x32, 0x02, 0xc2,
-	0x80, 0xad, 0xc2, 0x82, 0x22, 0x31, 0xd4, 0x84, 0x8e, 0xa8, 0x72, 0x06, 0xa4, 0x2e, 0xd1, 0xd9,
-	0x39, 0x1c, 0x2b, 0xb6, 0x9f, 0xb9, 0xbb, 0x14, 0xba, 0xf2, 0x09, 0x10, 0x13, 0x6c, 0x9d, 0xf9,
-	0x24, 0x1d, 0x3b, 0x22, 0x24, 0x0c, 0x4a, 0x16, 0x16, 0x96, 0x7c, 0x02, 0xe4, 0xbb, 0x8b, 0x9a,
-	0x20, 0x54, 0x60, 0x49, 0x7c, 0xfe, 0xbd, 0xf7, 0xfc, 0x7f, 0xff, 0x77, 0x0f, 0x9b, 0x24, 0x06,
-	0x96, 0x44, 0xde, 0x61, 0x48, 0xf2, 0x89, 0xfa, 0x75, 0x0b, 0x06, 0x02, 0x8c, 0x2d, 0x45, 0x5c,
-	0xf9, 0xae, 0xbd, 0x1d, 0x43, 0x0c, 0x12, 0x78, 0xd5, 0x93, 0x8a, 0x69, 0x5b, 0x11, 0xf0, 0x0c,
-	0xb8, 0x17, 0x12, 0x4e, 0xbd, 0xc3, 0x9d, 0x90, 0x0a, 0xb2, 0xe3, 0x45, 0x90, 0xe4, 0x8a, 0x3b,
-	0x3f, 0xeb, 0x78, 0x63, 0x9f, 0x30, 0x92, 0x71, 0x63, 0x8c, 0x6f, 0x31, 0xfa, 0x9a, 0xb0, 0xd1,
-	0x90, 0x16, 0x10, 0x8d, 0x87, 0xa3, 0x29, 0x23, 0x22, 0x81, 0x7c, 0x18, 0xa6, 0x10, 0x4d, 0xb8,
-	0x89, 0x3a, 0xa8, 0xdb, 0xf0, 0xef, 0x2d, 0x4a, 0xfb, 0xf6, 0x11, 0xc9, 0xd2, 0x9e, 0x73, 0x5e,
-	0xb4, 0x13, 0xb4, 0x14, 0x7e, 0x56, 0xd1, 0xbe, 0x86, 0xbe, 0x64, 0xc6, 0x7b, 0x84, 0x5b, 0x05,
-	0x65, 0x3a, 0x53, 0x97, 0x79, 0xc9, 0x48, 0x54, 0xc5, 0x98, 0xf5, 0x0e, 0xea, 0x5e, 0xf2, 0x5f,
-	0x9c, 0x94, 0x76, 0xed, 0x4b, 0x69, 0xdf, 0x8d, 0x13, 0x31, 0x9e, 0x86, 0x6e, 0x04, 0x99, 0xa7,
-	0x7b, 0x51, 0x7f, 0x0f, 0xf8, 0x68, 0xe2, 0x89, 0xa3, 0x82, 0x72, 0xb7, 0x4f, 0xa3, 0x45, 0x69,
-	0xdf, 0x51, 0xaa, 0x46, 0x09, 0x8f, 0x18, 0x15, 0xf4, 0xcf, 0xd5, 0x9d, 0xe0, 0x7a, 0x41, 0x99,
-	0x14, 0x15, 0x48, 0xb2, 0xa7, 0x81, 0x71, 0x80, 0x6f, 0xe8, 0x58, 0x9e, 0x01, 0x88, 0x71, 0x92,
-	0xc7, 0xcb, 0xce, 0x1b, 0xb2, 0x73, 0x67, 0x51, 0xda, 0xd6, 0x5a, 0xe7, 0xbf, 0x07, 0x3a, 0xc1,
-	0x35, 0x45, 0x06, 0x4b, 0xa0, 0x1a, 0xee, 0x5d, 0xfc, 0x70, 0x6c, 0xd7, 0x7e, 0x1c, 0xdb, 0xc8,
-	0xf9, 0xda, 0xc0, 0x17, 0x06, 0x82, 0x08, 0x6a, 0xbc, 0x45, 0x78, 0x53, 0xd7, 0x29, 0x00, 0x52,
-	0x13, 0x75, 0x1a, 0xdd, 0xcd, 0x87, 0x2d, 0x57, 0x75, 0xe7, 0x56, 0x03, 0x73, 0xf5, 0xc0, 0xdc,
-	0xa7, 0x90, 0xe4, 0xfe, 0x5e, 0xe5, 0xc8, 0xa2, 0xb4, 0x8d, 0x35, 0x0d, 0x55, 0xae, 0xf3, 0xe9,
-	0x9b, 0xdd, 0xfd, 0x07, 0x9f, 0xaa, 0x32, 0x3c, 0xc0, 0x2a, 0x73, 0x1f, 0x20, 0x35, 0x3e, 0x22,
-	0x7c, 0x55, 0x17, 0x92, 0x2d, 0x0c, 0x49, 0x06, 0xd3, 0x5c, 0x98, 0xf5, 0xbf, 0x89, 0x79, 0xae,
-	0xc5, 0xb4, 0xd7, 0xc4, 0xac, 0xd6, 0xf8, 0x3f, 0x51, 0x57, 0x54, 0x05, 0xe9, 0xd7, 0xae, 0xcc,
-	0x37, 0x9e, 0xe0, 0xcb, 0x29, 0xe1, 0x62, 0xc8, 0xe9, 0xab, 0x29, 0xcd, 0x23, 0x2a, 0xc7, 0xd0,
-	0xf4, 0xcd, 0x45, 0x69, 0x6f, 0xab, 0xaf, 0xae, 0x61, 0x27, 0xd8, 0xaa, 0xce, 0x03, 0x7d, 0x34,
-	0x72, 0x6c, 0x49, 0xae, 0xa5, 0x8d, 0x12, 0x2e, 0x58, 0x12, 0x4e, 0xcf, 0xee, 0xa8, 0xd9, 0x94,
-	0x63, 0xbd, 0x7f, 0x76, 0x75, 0xce, 0x8f, 0x77, 0x82, 0x9b, 0x55, 0x80, 0xba, 0x36, 0xfd, 0x15,
-	0x2c, 0x45, 0xf7, 0x9a, 0xd5, 0x7c, 0xfd, 0xe0, 0x64, 0x66, 0xa1, 0xd3, 0x99, 0x85, 0xbe, 0xcf,
-	0x2c, 0xf4, 0x6e, 0x6e, 0xd5, 0x4e, 0xe7, 0x56, 0xed, 0xf3, 0xdc, 0xaa, 0x1d, 0x3c, 0x5e, 0xf1,
-	0x62, 0x57, 0xad, 0xb4, 0xda, 0x5f, 0xe9, 0x45, 0x0c, 0x29, 0xc9, 0xe3, 0xa5, 0x49, 0x6f, 0xf4,
-	0xb6, 0x4b, 0x87, 0xc2, 0x0d, 0xb9, 0xaa, 0x8f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x95,
-	0x56, 0xb1, 0x0a, 0x04, 0x00, 0x00,
+	// 1131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xcd, 0xc6, 0x76, 0xbe, 0xcd, 0x38, 0xfd, 0x35, 0xcd, 0x37, 0xd9, 0xb4, 0x91, 0xd7, 0x9a,
+	0x40, 0x31, 0x12, 0xd8, 0xb4, 0x1c, 0xa8, 0x22, 0x21, 0xc8, 0x92, 0x06, 0x90, 0x68, 0x15, 0x4d,
+	0x40, 0x95, 0x2a, 0xa1, 0xd5, 0xec, 0xee, 0x60, 0xaf, 0xb2, 0xbb, 0xe3, 0xcc, 0x8c, 0x13, 0x7a,
+	0xe1, 0x00, 0x17, 0xc4, 0x09, 0x71, 0x82, 0x5b, 0xcf, 0x88, 0xff, 0x80, 0x33, 0x52, 0x8f, 0x39,
+	0x22, 0x0e, 0x0b, 0x4a, 0x2e, 0x88, 0x03, 0x87, 0xfd, 0x0b, 0xd0, 0xce, 0x8c, 0xe3, 0xb5, 0x93,
+	0x26, 0xf5, 0x09, 0x2e, 0x89, 0x67, 0xdf, 0x9b, 0xcf, 0xaf, 0x7d, 0xf3, 0x66, 0x81, 0x4d, 0xba,
+	0x8c, 0x47, 0x41, 0x67, 0xdf, 0x27, 0xe9, 0xae, 0xfe, 0xdb, 0xee, 0x73, 0x26, 0x19, 0x5c, 0xd0,
+	0x48, 0x5b, 0x3d, 0xbb, 0xb9, 0xd8, 0x65, 0x5d, 0xa6, 0x80, 0x4e, 0xf1, 0x4b, 0x73, 0x6e, 0x36,
+	0x02, 0x26, 0x12, 0x26, 0x3a, 0x3e, 0x11, 0xb4, 0xb3, 0x7f, 0xc7, 0xa7, 0x92, 0xdc, 0xe9, 0x04,
+	0x2c, 0x4a, 0x35, 0x8e, 0x7e, 0xaa, 0x81, 0xb9, 0x6d, 0xc2, 0x49, 0x22, 0x60, 0x0f, 0xac, 0x72,
+	0x7a, 0x40, 0x78, 0xe8, 0xd1, 0x3e, 0x0b, 0x7a, 0x5e, 0x38, 0xe0, 0x44, 0x46, 0x2c, 0xf5, 0xfc,
+	0x98, 0x05, 0xbb, 0xc2, 0xb6, 0x9a, 0x56, 0xab, 0xe2, 0xbe, 0x92, 0x67, 0xce, 0xda, 0x13, 0x92,
+	0xc4, 0xeb, 0xe8, 0x3c, 0x36, 0xc2, 0x2b, 0x1a, 0xbe, 0x5f, 0xa0, 0x9b, 0x06, 0x74, 0x15, 0x06,
+	0xbf, 0xb3, 0xc0, 0x4a, 0x9f, 0x72, 0xb3, 0xd3, 0x84, 0xf9, 0x8c, 0x93, 0xa0, 0xe0, 0xd8, 0xb3,
+	0x4d, 0xab, 0x35, 0xef, 0x3e, 0x7a, 0x96, 0x39, 0x33, 0xbf, 0x65, 0xce, 0xed, 0x6e, 0x24, 0x7b,
+	0x03, 0xbf, 0x1d, 0xb0, 0xa4, 0x63, 0x7a, 0xd1, 0xff, 0x5e, 0x17, 0xe1, 0x6e, 0x47, 0x3e, 0xe9,
+	0x53, 0xd1, 0xde, 0xa4, 0x41, 0x9e, 0x39, 0x2f, 0xeb, 0xaa, 0xc2, 0x48, 0x04, 0x9c, 0x4a, 0x7a,
+	0x76, 0x74, 0x84, 0x97, 0xfa, 0x94, 0xab, 0xa2, 0xb0, 0x42, 0xb6, 0x0c, 0x00, 0x1f, 0x83, 0x65,
+	0xc3, 0x15, 0x09, 0x63, 0xb2, 0x17, 0xa5, 0xdd, 0x61, 0xe7, 0x15, 0xd5, 0x39, 0xca, 0x33, 0xa7,
+	0x31, 0xd6, 0xf9, 0x24, 0x11, 0xe1, 0xff, 0x6b, 0x64, 0x67, 0x08, 0x98, 0x86, 0x25, 0xb8, 0x61,
+	0xb6, 0x84, 0x54, 0xc8, 0x28, 0x55, 0xc3, 0x10, 0x76, 0xb5, 0x59, 0x69, 0xd5, 0xef, 0x3a, 0xed,
+	0xf2, 0x7b, 0x6c, 0xeb, 0xb2, 0x36, 0x47, 0x3c, 0x17, 0x15, 0xa3, 0xc8, 0x33, 0xe7, 0xe6, 0x58,
+	0xf2, 0x72, 0x24, 0x84, 0x21, 0x9f, 0xdc, 0x26, 0xe0, 0xa7, 0xc0, 0x16, 0x54, 0x4a, 0xe2, 0xc7,
+	0xd4, 0x4b, 0xa8, 0x24, 0x21, 0x91, 0xc4, 0x0b, 0x69, 0xca, 0x12, 0x61, 0xd7, 0x9a, 0x95, 0xd6,
+	0xbc, 0xbb, 0x96, 0x67, 0x8e, 0xa3, 0xa3, 0x3e, 0x8f, 0x89, 0xf0, 0xd2, 0x10, 0x7a, 0x60, 0x90,
+	0x4d, 0x05, 0xc0, 0x1e, 0xb8, 0xa6, 0x28, 0x1e, 0x89, 0x63, 0x76, 0x40, 0xd2, 0x80, 0x0a, 0x7b,
+	0x4e, 0x75, 0xb4, 0x3a, 0xde, 0x91, 0xe2, 0x6f, 0x0c, 0x49, 0xae, 0x63, 0xda, 0x59, 0x36, 0xef,
+	0x6b, 0x22, 0x06, 0xc2, 0x57, 0xc3, 0xb1, 0x0d, 0x62, 0xfd, 0xd2, 0xf7, 0x4f, 0x9d, 0x99, 0x3f,
+	0x9f, 0x3a, 0x16, 0xfa, 0x7a, 0x16, 0x5c, 0x19, 0x0f, 0x07, 0x6f, 0x83, 0x9a, 0xe2, 0x2b, 0x7d,
+	0xce, 0xbb, 0xd7, 0xf2, 0xcc, 0x59, 0x28, 0x45, 0x46, 0x58, 0xc3, 0x70, 0x0d, 0x54, 0xbb, 0xd1,
+	0x3e, 0x55, 0xf2, 0xba, 0xe4, 0x5e, 0xcd, 0x33, 0xa7, 0xae, 0x69, 0xc5, 0x53, 0x84, 0x15, 0xa8,
+	0x48, 0x9c, 0xf8, 0x76, 0xe5, 0x14, 0x89, 0x13, 0xbf, 0x20, 0x71, 0xe2, 0xc3, 0x2f, 0xc0, 0x62,
+	0x41, 0xf6, 0xe2, 0x28, 0x89, 0xa4, 0x57, 0x08, 0x59, 0xbd, 0x7e, 0xbb, 0xaa, 0x0a, 0x78, 0x30,
+	0x85, 0x70, 0x3f, 0x4c, 0x65, 0x9e, 0x39, 0xb7, 0x46, 0x75, 0x4c, 0xc6, 0x44, 0xf8, 0x7a, 0xf1,
+	0xf8, 0xa3, 0xe2, 0xe9, 0x36, 0xe5, 0x4a, 0x4e, 0xeb, 0x55, 0x35, 0x8a, 0x43, 0x0b, 0x5c, 0x3f,
+	0xa5, 0x95, 0xa2, 0x81, 0xdd, 0x28, 0x0d, 0xcd, 0x30, 0x4a, 0x0d, 0x14, 0x4f, 0x11, 0x56, 0x20,
+	0x7c, 0x0b, 0xd4, 0x13, 0x16, 0x0e, 0x62, 0xea, 0xa5, 0x24, 0xa1, 0xe6, 0xc0, 0x2d, 0xe5, 0x99,
+	0x03, 0x35, 0xb7, 0x04, 0x22, 0x0c, 0xf4, 0xea, 0x21, 0x49, 0x28, 0x7c, 0x04, 0xe6, 0x0e, 0x68,
+	0xd4, 0xed, 0x49, 0x35, 0xa0, 0x79, 0xf7, 0x9d, 0xa9, 0x0f, 0xe9, 0x65, 0x9d, 0x41, 0x47, 0x41,
+	0xd8, 0x84, 0x33, 0x2d, 0x65, 0x16, 0x58, 0x3a, 0xd5, 0xd2, 0xc7, 0x4c, 0x92, 0x18, 0xde, 0x03,
+	0xf5, 0x92, 0xe0, 0x6d, 0x6b, 0xb2, 0xe4, 0x12, 0x88, 0x70, 0x99, 0x0a, 0xbf, 0xb2, 0x40, 0x3d,
+	0x8c, 0x84, 0xe4, 0x91, 0x3f, 0x90, 0x34, 0xb4, 0x67, 0x95, 0x44, 0x57, 0xda, 0xba, 0xc0, 0x76,
+	0x61, 0x8c, 0x6d, 0x63, 0x8c, 0xed, 0xf7, 0x58, 0x94, 0xba, 0x5b, 0x46, 0x9f, 0xf0, 0xc4, 0x4f,
+	0x86, 0x7b, 0xd1, 0x8f, 0xbf, 0x3b, 0xad, 0x17, 0x68, 0xb5, 0x08, 0x23, 0x70, 0x39, 0xab, 0x69,
+	0xf0, 0xaf, 0x2a, 0xa8, 0xed, 0x48, 0x22, 0x29, 0xfc, 0xd2, 0x02, 0x75, 0x73, 0x90, 0xfb, 0x8c,
+	0xc5, 0xb6, 0x35, 0x65, 0x55, 0xa5, 0xbd, 0xd3, 0x55, 0x05, 0xf4, 0xce, 0x6d, 0xc6, 0x62, 0xf8,
+	0x83, 0x75, 0xe2, 0x4b, 0x4a, 0x6d, 0x1e, 0x49, 0xd8, 0x20, 0x95, 0x17, 0x8f, 0xe8, 0xe1, 0x99,
+	0x8e, 0x54, 0x8e, 0x31, 0x5d, 0x51, 0xd7, 0x75, 0x04, 0x25, 0xef, 0x0d, 0xb5, 0x1f, 0xbe, 0x0d,
+	0x2e, 0xc7, 0x44, 0x48, 0x4f, 0xd0, 0xbd, 0x01, 0x4d, 0x03, 0xaa, 0x14, 0x57, 0x75, 0xed, 0x3c,
+	0x73, 0x16, 0x75, 0xd6, 0x31, 0x18, 0xe1, 0x85, 0x62, 0xbd, 0x63, 0x96, 0x30, 0x05, 0x0d, 0x85,
+	0x0f, 0xcd, 0x72, 0xf8, 0x2a, 0x4e, 0x6e, 0x28, 0x75, 0x5a, 0x2b, 0xee, 0xab, 0xa3, 0x8b, 0xe3,
+	0x7c, 0x3e, 0xc2, 0xb7, 0x0a, 0x82, 0x91, 0x67, 0x09, 0x56, 0x45, 0xc3, 0x6f, 0x2c, 0xb0, 0x72,
+	0xda, 0x98, 0x3d, 0x59, 0x88, 0x57, 0xbb, 0x6d, 0xfd, 0xee, 0x4b, 0x17, 0x18, 0xbd, 0x52, 0xba,
+	0xdb, 0x32, 0xb3, 0x6d, 0x3e, 0xcf, 0xed, 0x4d, 0x50, 0x84, 0x97, 0xf9, 0x99, 0x11, 0x84, 0x11,
+	0xdb, 0x1e, 0xb8, 0xe1, 0x92, 0xb8, 0xf0, 0xc8, 0x9d, 0x81, 0x2f, 0x02, 0x1e, 0xf5, 0xd5, 0x79,
+	0x78, 0x0d, 0xfc, 0x8f, 0x84, 0x21, 0xa7, 0x42, 0x98, 0x53, 0x04, 0xf3, 0xcc, 0xb9, 0xa2, 0x93,
+	0x19, 0x00, 0xe1, 0x21, 0x65, 0xe4, 0xae, 0xb3, 0xe7, 0xba, 0xab, 0x49, 0xf9, 0xf7, 0x2c, 0xa8,
+	0xe3, 0xd1, 0xb5, 0x5f, 0x18, 0x8d, 0x90, 0x84, 0x4b, 0x33, 0x72, 0xfd, 0x05, 0x51, 0x3a, 0xb5,
+	0x25, 0x10, 0x61, 0xa0, 0x56, 0x7a, 0x9c, 0xff, 0x65, 0x65, 0x4e, 0x1a, 0x4a, 0xe5, 0x5f, 0x34,
+	0x94, 0x5f, 0x2c, 0x70, 0xe5, 0xfd, 0x68, 0x9f, 0xaa, 0xfa, 0x3e, 0x11, 0xa4, 0x4b, 0xe1, 0x3a,
+	0x58, 0xd0, 0xed, 0xf6, 0xb4, 0x53, 0xeb, 0xa1, 0x2f, 0xe7, 0x99, 0x73, 0x43, 0xe7, 0x2f, 0xa3,
+	0x08, 0xd7, 0xd5, 0xf2, 0x03, 0xb5, 0x82, 0x7b, 0xa0, 0x56, 0x5c, 0x37, 0xe9, 0xc5, 0x73, 0x7e,
+	0xd7, 0xf4, 0xb4, 0x30, 0xba, 0xbb, 0xd2, 0xe9, 0xba, 0xd1, 0x99, 0x4c, 0x1f, 0x3f, 0x5b, 0x60,
+	0xf5, 0xfe, 0x3e, 0x4d, 0xe5, 0xa9, 0x43, 0xb1, 0x45, 0xa2, 0x98, 0x86, 0xb0, 0x79, 0x86, 0xff,
+	0x8f, 0xfb, 0x7c, 0x00, 0xe6, 0x5e, 0x54, 0x24, 0x6f, 0x14, 0xc5, 0x4f, 0x55, 0xac, 0x09, 0x0d,
+	0x17, 0x41, 0x8d, 0x72, 0xce, 0xb8, 0xbe, 0xff, 0xb0, 0x5e, 0xb8, 0xf8, 0xd9, 0x51, 0xc3, 0x3a,
+	0x3c, 0x6a, 0x58, 0x7f, 0x1c, 0x35, 0xac, 0x6f, 0x8f, 0x1b, 0x33, 0x87, 0xc7, 0x8d, 0x99, 0x5f,
+	0x8f, 0x1b, 0x33, 0x8f, 0xef, 0x95, 0x32, 0x6c, 0xe8, 0xef, 0x78, 0xed, 0x01, 0x2a, 0x43, 0x97,
+	0xc5, 0x24, 0xed, 0x0e, 0x53, 0x7f, 0x6e, 0x3e, 0xf1, 0x55, 0x5e, 0x7f, 0x4e, 0x7d, 0x9f, 0xbf,
+	0xf9, 0xcf, 0x00, 0x69, 0xd5, 0xc8, 0x5c, 0xff, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -236,16 +745,135 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RewardSmoothingBlocks != that1.RewardSmoothingBlocks {
 		return false
 	}
-	return true
-}
-func (this *State) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.RewardDestinations) != len(that1.RewardDestinations) {
+		return false
 	}
-
-	that1, ok := that.(*State)
-	if !ok {
-		that2, ok := that.(State)
+	for i := range this.RewardDestinations {
+		if !this.RewardDestinations[i].Equal(&that1.RewardDestinations[i]) {
+			return false
+		}
+	}
+	if len(this.SettableMetadataDenoms) != len(that1.SettableMetadataDenoms) {
+		return false
+	}
+	for i := range this.SettableMetadataDenoms {
+		if this.SettableMetadataDenoms[i] != that1.SettableMetadataDenoms[i] {
+			return false
+		}
+	}
+	if len(this.DenomAllowances) != len(that1.DenomAllowances) {
+		return false
+	}
+	for i := range this.DenomAllowances {
+		if !this.DenomAllowances[i].Equal(&that1.DenomAllowances[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomAllowance)
+	if !ok {
+		that2, ok := that.(DenomAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Give != that1.Give {
+		return false
+	}
+	if this.Grab != that1.Grab {
+		return false
+	}
+	if !this.GiveLimitPerBlock.Equal(that1.GiveLimitPerBlock) {
+		return false
+	}
+	return true
+}
+func (this *RewardDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardDestination)
+	if !ok {
+		that2, ok := that.(RewardDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *RewardDestinationTotal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardDestinationTotal)
+	if !ok {
+		that2, ok := that.(RewardDestinationTotal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if len(this.Distributed) != len(that1.Distributed) {
+		return false
+	}
+	for i := range this.Distributed {
+		if !this.Distributed[i].Equal(&that1.Distributed[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *State) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*State)
+	if !ok {
+		that2, ok := that.(State)
 		if ok {
 			that1 = &that2
 		} else {
@@ -279,6 +907,113 @@ func (this *State) Equal(that interface{}) bool {
 	if this.LastRewardDistributionBlock != that1.LastRewardDistributionBlock {
 		return false
 	}
+	if len(this.RewardDestinationTotals) != len(that1.RewardDestinationTotals) {
+		return false
+	}
+	for i := range this.RewardDestinationTotals {
+		if !this.RewardDestinationTotals[i].Equal(&that1.RewardDestinationTotals[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BalanceSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BalanceSubscription)
+	if !ok {
+		that2, ok := that.(BalanceSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
+}
+func (this *RewardEpoch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardEpoch)
+	if !ok {
+		that2, ok := that.(RewardEpoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartBlock != that1.StartBlock {
+		return false
+	}
+	if len(this.RewardBlockAmount) != len(that1.RewardBlockAmount) {
+		return false
+	}
+	for i := range this.RewardBlockAmount {
+		if !this.RewardBlockAmount[i].Equal(&that1.RewardBlockAmount[i]) {
+			return false
+		}
+	}
+	if len(this.Distributed) != len(that1.Distributed) {
+		return false
+	}
+	for i := range this.Distributed {
+		if !this.Distributed[i].Equal(&that1.Distributed[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GiveBlockUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GiveBlockUsage)
+	if !ok {
+		that2, ok := that.(GiveBlockUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if len(this.Given) != len(that1.Given) {
+		return false
+	}
+	for i := range this.Given {
+		if !this.Given[i].Equal(&that1.Given[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -301,6 +1036,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomAllowances) > 0 {
+		for iNdEx := len(m.DenomAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SettableMetadataDenoms) > 0 {
+		for iNdEx := len(m.SettableMetadataDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SettableMetadataDenoms[iNdEx])
+			copy(dAtA[i:], m.SettableMetadataDenoms[iNdEx])
+			i = encodeVarintVbank(dAtA, i, uint64(len(m.SettableMetadataDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardDestinations) > 0 {
+		for iNdEx := len(m.RewardDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.RewardSmoothingBlocks != 0 {
 		i = encodeVarintVbank(dAtA, i, uint64(m.RewardSmoothingBlocks))
 		i--
@@ -324,7 +1096,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
+func (m *DenomAllowance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -334,119 +1106,1581 @@ func (m *State) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomAllowance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastRewardDistributionBlock != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.LastRewardDistributionBlock))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.GiveLimitPerBlock.Size()
+		i -= size
+		if _, err := m.GiveLimitPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
 	}
-	if m.LastSequence != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.LastSequence))
+	i--
+	dAtA[i] = 0x22
+	if m.Grab {
+		i--
+		if m.Grab {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.RewardBlockAmount) > 0 {
-		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVbank(dAtA, i, uint64(size))
+	if m.Give {
+		i--
+		if m.Give {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDestinationTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDestinationTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDestinationTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardDestinationTotals) > 0 {
+		for iNdEx := len(m.RewardDestinationTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDestinationTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastRewardDistributionBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.LastRewardDistributionBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSequence != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.LastSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GiveBlockUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GiveBlockUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GiveBlockUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Given) > 0 {
+		for iNdEx := len(m.Given) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Given[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardDestinationFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardDestinationFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardDestinationFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVbank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVbank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RewardEpochDurationBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.RewardEpochDurationBlocks))
+	}
+	l = m.PerEpochRewardFraction.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	if m.RewardSmoothingBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.RewardSmoothingBlocks))
+	}
+	if len(m.RewardDestinations) > 0 {
+		for _, e := range m.RewardDestinations {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.SettableMetadataDenoms) > 0 {
+		for _, s := range m.SettableMetadataDenoms {
+			l = len(s)
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.DenomAllowances) > 0 {
+		for _, e := range m.DenomAllowances {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if m.Give {
+		n += 2
+	}
+	if m.Grab {
+		n += 2
+	}
+	l = m.GiveLimitPerBlock.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	return n
+}
+
+func (m *RewardDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	return n
+}
+
+func (m *RewardDestinationTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for _, e := range m.RewardBlockAmount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.LastSequence != 0 {
+		n += 1 + sovVbank(uint64(m.LastSequence))
+	}
+	if m.LastRewardDistributionBlock != 0 {
+		n += 1 + sovVbank(uint64(m.LastRewardDistributionBlock))
+	}
+	if len(m.RewardDestinationTotals) > 0 {
+		for _, e := range m.RewardDestinationTotals {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BalanceSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovVbank(uint64(m.StartBlock))
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for _, e := range m.RewardBlockAmount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GiveBlockUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovVbank(uint64(m.BlockHeight))
+	}
+	if len(m.Given) > 0 {
+		for _, e := range m.Given {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardDestinationFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	return n
+}
+
+func sovVbank(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVbank(x uint64) (n int) {
+	return sovVbank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDurationBlocks", wireType)
+			}
+			m.RewardEpochDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochDurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerEpochRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSmoothingBlocks", wireType)
+			}
+			m.RewardSmoothingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardSmoothingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDestinations = append(m.RewardDestinations, RewardDestination{})
+			if err := m.RewardDestinations[len(m.RewardDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettableMetadataDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettableMetadataDenoms = append(m.SettableMetadataDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAllowances = append(m.DenomAllowances, DenomAllowance{})
+			if err := m.DenomAllowances[len(m.DenomAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Give", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Give = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grab", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Grab = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GiveLimitPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GiveLimitPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardDestinationTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardDestinationTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardDestinationTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
+			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+			}
+			m.LastSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardDistributionBlock", wireType)
+			}
+			m.LastRewardDistributionBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardDistributionBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDestinationTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDestinationTotals = append(m.RewardDestinationTotals, RewardDestinationTotal{})
+			if err := m.RewardDestinationTotals[len(m.RewardDestinationTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.RewardPool) > 0 {
-		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVbank(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
 			}
-			i--
-			dAtA[i] = 0xa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintVbank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVbank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RewardEpochDurationBlocks != 0 {
-		n += 1 + sovVbank(uint64(m.RewardEpochDurationBlocks))
-	}
-	l = m.PerEpochRewardFraction.Size()
-	n += 1 + l + sovVbank(uint64(l))
-	if m.RewardSmoothingBlocks != 0 {
-		n += 1 + sovVbank(uint64(m.RewardSmoothingBlocks))
-	}
-	return n
-}
 
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RewardPool) > 0 {
-		for _, e := range m.RewardPool {
-			l = e.Size()
-			n += 1 + l + sovVbank(uint64(l))
-		}
-	}
-	if len(m.RewardBlockAmount) > 0 {
-		for _, e := range m.RewardBlockAmount {
-			l = e.Size()
-			n += 1 + l + sovVbank(uint64(l))
-		}
-	}
-	if m.LastSequence != 0 {
-		n += 1 + sovVbank(uint64(m.LastSequence))
-	}
-	if m.LastRewardDistributionBlock != 0 {
-		n += 1 + sovVbank(uint64(m.LastRewardDistributionBlock))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVbank(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVbank(x uint64) (n int) {
-	return sovVbank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RewardEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -469,17 +2703,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDurationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			m.RewardEpochDurationBlocks = 0
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -489,16 +2723,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardEpochDurationBlocks |= int64(b&0x7F) << shift
+				m.StartBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -508,31 +2742,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVbank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVbank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PerEpochRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
+			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardSmoothingBlocks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
-			m.RewardSmoothingBlocks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -542,11 +2776,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardSmoothingBlocks |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
@@ -568,7 +2817,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *GiveBlockUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,15 +2840,34 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: GiveBlockUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GiveBlockUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Given", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -626,16 +2894,66 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPool = append(m.RewardPool, types.Coin{})
-			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Given = append(m.Given, types.Coin{})
+			if err := m.Given[len(m.Given)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardDestinationFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardDestinationFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardDestinationFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -645,31 +2963,29 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVbank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVbank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
-			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.LastSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -679,16 +2995,31 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardDistributionBlock", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
 			}
-			m.LastRewardDistributionBlock = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -698,11 +3029,24 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRewardDistributionBlock |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
@@ -8,8 +8,11 @@ import (
 	"sort"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type portHandler struct {
@@ -25,6 +28,47 @@ type portMessage struct { // comes from swingset's vat-bank
 	ModuleName string `json:"moduleName"`
 	Denom      string `json:"denom"`
 	Amount     string `json:"amount"`
+	// Queries are the balances requested by VBANK_GET_BALANCES or VBANK_RESYNC
+	Queries []vbankBalanceQuery `json:"queries"`
+	// Transfers are the legs moved by VBANK_TRANSFER_BATCH
+	Transfers []vbankTransfer `json:"transfers"`
+	// Metadata is the bank denom metadata set by VBANK_SET_DENOM_METADATA
+	Metadata json.RawMessage `json:"metadata"`
+	// Addresses are the accounts all of whose balances VBANK_RESYNC returns
+	Addresses []string `json:"addresses"`
+}
+
+// vbankDenomTrace is the IBC denom trace of a vbankDenomMetadata.
+type vbankDenomTrace struct {
+	Path      string `json:"path"`
+	BaseDenom string `json:"baseDenom"`
+}
+
+// vbankDenomMetadata is the result of VBANK_GET_DENOM_METADATA. Metadata is
+// the bank denom metadata, if any, and Trace is the IBC denom trace of an
+// "ibc/..." denom, if known.
+type vbankDenomMetadata struct {
+	Denom    string           `json:"denom"`
+	Metadata json.RawMessage  `json:"metadata"`
+	Trace    *vbankDenomTrace `json:"trace,omitempty"`
+}
+
+// vbankBalanceQuery requests the balance of an address in a denom, or in all
+// the denoms it holds if the denom is empty.
+type vbankBalanceQuery struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+}
+
+// vbankTransfer is a single leg of VBANK_TRANSFER_BATCH. Each side is either a
+// user account address or the name of a module account, but not both.
+type vbankTransfer struct {
+	Sender          string `json:"sender"`
+	SenderModule    string `json:"senderModule"`
+	Recipient       string `json:"recipient"`
+	RecipientModule string `json:"recipientModule"`
+	Denom           string `json:"denom"`
+	Amount          string `json:"amount"`
 }
 
 func NewPortHandler(am AppModule, keeper Keeper) portHandler {
@@ -67,6 +111,13 @@ func (vbu vbankManyBalanceUpdates) Swap(i int, j int) {
 	vbu[i], vbu[j] = vbu[j], vbu[i]
 }
 
+// vbankBalanceResult is the result of a vbankBalanceQuery. A query which
+// cannot be satisfied results in an error and no amount.
+type vbankBalanceResult struct {
+	vbankSingleBalanceUpdate
+	Error string `json:"error,omitempty"`
+}
+
 type vbankBalanceUpdate struct {
 	vm.ActionHeader `actionType:"VBANK_BALANCE_UPDATE"`
 	Nonce           uint64                  `json:"nonce"`
@@ -115,6 +166,196 @@ func getBalanceUpdate(ctx sdk.Context, keeper Keeper, addressToUpdate map[string
 	return vm.PopulateAction(ctx, event)
 }
 
+// vbankResync is the result of VBANK_RESYNC: the requested balances,
+// reflecting all balance updates up to and including the one with Nonce.
+type vbankResync struct {
+	Nonce   uint64                  `json:"nonce"`
+	Updated vbankManyBalanceUpdates `json:"updated"`
+}
+
+// getResync returns a snapshot of the queried balances. A query for a denom
+// always reports it, even with a zero amount, so that the VM can learn of a
+// balance which dropped to zero. A query without a denom reports all the
+// denoms held by the address.
+func getResync(ctx sdk.Context, keeper Keeper, queries []vbankBalanceQuery) (vbankResync, error) {
+	resync := vbankResync{
+		Nonce:   keeper.GetState(ctx).LastSequence,
+		Updated: vbankManyBalanceUpdates{},
+	}
+	seen := make(map[vbankBalanceQuery]bool, len(queries))
+	addUpdate := func(address string, coin sdk.Coin) {
+		key := vbankBalanceQuery{Address: address, Denom: coin.Denom}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		resync.Updated = append(resync.Updated, vbankSingleBalanceUpdate{
+			Address: address,
+			Denom:   coin.Denom,
+			Amount:  coin.Amount.String(),
+		})
+	}
+	for _, query := range queries {
+		addr, err := sdk.AccAddressFromBech32(query.Address)
+		if err != nil {
+			return resync, fmt.Errorf("cannot convert %s to address: %s", query.Address, err)
+		}
+		if query.Denom == "" {
+			for _, coin := range keeper.GetAllBalances(ctx, addr) {
+				addUpdate(query.Address, coin)
+			}
+			continue
+		}
+		if err = sdk.ValidateDenom(query.Denom); err != nil {
+			return resync, fmt.Errorf("invalid denom %s: %s", query.Denom, err)
+		}
+		addUpdate(query.Address, keeper.GetBalance(ctx, addr, query.Denom))
+	}
+
+	// Ensure we have a deterministic order of updates.
+	sort.Sort(resync.Updated)
+	return resync, nil
+}
+
+// getBalances returns the results of the balance queries, deduplicated and
+// sorted in a deterministic order.
+func getBalances(ctx sdk.Context, keeper Keeper, queries []vbankBalanceQuery) []vbankBalanceResult {
+	results := make([]vbankBalanceResult, 0, len(queries))
+	seen := make(map[vbankBalanceQuery]bool, len(queries))
+	addResult := func(address, denom, amount string, err error) {
+		key := vbankBalanceQuery{Address: address, Denom: denom}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		result := vbankBalanceResult{
+			vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{
+				Address: address,
+				Denom:   denom,
+				Amount:  amount,
+			},
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	for _, query := range queries {
+		addr, err := sdk.AccAddressFromBech32(query.Address)
+		if err != nil {
+			addResult(query.Address, query.Denom, "", fmt.Errorf("cannot convert %s to address: %s", query.Address, err))
+			continue
+		}
+		if query.Denom == "" {
+			for _, coin := range keeper.GetAllBalances(ctx, addr) {
+				addResult(query.Address, coin.Denom, coin.Amount.String(), nil)
+			}
+			continue
+		}
+		if err = sdk.ValidateDenom(query.Denom); err != nil {
+			addResult(query.Address, query.Denom, "", fmt.Errorf("invalid denom %s: %s", query.Denom, err))
+			continue
+		}
+		coin := keeper.GetBalance(ctx, addr, query.Denom)
+		addResult(query.Address, query.Denom, coin.Amount.String(), nil)
+	}
+
+	// Ensure we have a deterministic order of results.
+	sort.SliceStable(results, func(i, j int) bool {
+		return vbankManyBalanceUpdates{results[i].vbankSingleBalanceUpdate, results[j].vbankSingleBalanceUpdate}.Less(0, 1)
+	})
+	return results
+}
+
+// transferModuleAccounts are the module accounts that may be named as one side
+// of a vbankTransfer. Other module accounts, such as the staking pools, must
+// not be drained or credited directly by the controller.
+var transferModuleAccounts = map[string]bool{
+	types.ReservePoolName:   true,
+	types.ProvisionPoolName: true,
+	types.GiveawayPoolName:  true,
+}
+
+// isTransferModuleAddress returns whether addr is the address of one of the
+// transferModuleAccounts.
+func isTransferModuleAddress(addr sdk.AccAddress) bool {
+	for name := range transferModuleAccounts {
+		if authtypes.NewModuleAddress(name).Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTransferParty returns the account of one side of a vbankTransfer,
+// given either its address or its module name. A module account given by its
+// address must also be one of the transferModuleAccounts.
+func resolveTransferParty(ctx sdk.Context, keeper Keeper, address, moduleName string) (sdk.AccAddress, error) {
+	switch {
+	case address != "" && moduleName != "":
+		return nil, fmt.Errorf("cannot specify both address %s and module %s", address, moduleName)
+	case moduleName != "":
+		if !transferModuleAccounts[moduleName] {
+			return nil, fmt.Errorf("module account %s is not allowed in transfers", moduleName)
+		}
+		addr := keeper.GetModuleAccountAddress(ctx, moduleName)
+		if len(addr) == 0 {
+			return nil, fmt.Errorf("module account %s not found", moduleName)
+		}
+		return addr, nil
+	default:
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %s to address: %s", address, err)
+		}
+		if keeper.IsModuleAccount(ctx, addr) && !isTransferModuleAddress(addr) {
+			return nil, fmt.Errorf("module account %s is not allowed in transfers", address)
+		}
+		return addr, nil
+	}
+}
+
+// transferBatch moves all the transfer legs, or none of them if any fails. It
+// returns the denoms touched for each address involved.
+func transferBatch(ctx sdk.Context, keeper Keeper, transfers []vbankTransfer) (map[string]sdk.Coins, error) {
+	if len(transfers) == 0 {
+		return nil, fmt.Errorf("no transfers")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	addressToBalances := make(map[string]sdk.Coins)
+	for i, leg := range transfers {
+		from, err := resolveTransferParty(cacheCtx, keeper, leg.Sender, leg.SenderModule)
+		if err != nil {
+			return nil, fmt.Errorf("transfer %d sender: %s", i, err)
+		}
+		to, err := resolveTransferParty(cacheCtx, keeper, leg.Recipient, leg.RecipientModule)
+		if err != nil {
+			return nil, fmt.Errorf("transfer %d recipient: %s", i, err)
+		}
+		if err = sdk.ValidateDenom(leg.Denom); err != nil {
+			return nil, fmt.Errorf("transfer %d: invalid denom %s: %s", i, leg.Denom, err)
+		}
+		value, ok := sdk.NewIntFromString(leg.Amount)
+		if !ok || !value.IsPositive() {
+			return nil, fmt.Errorf("transfer %d: cannot convert %s to positive int", i, leg.Amount)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(leg.Denom, value))
+		if err := keeper.TransferCoins(cacheCtx, from, to, coins); err != nil {
+			return nil, fmt.Errorf("transfer %d: cannot transfer %s coins: %s", i, coins.String(), err)
+		}
+		denomOnly := sdk.NewInt64Coin(leg.Denom, 1)
+		for _, addr := range []string{from.String(), to.String()} {
+			addressToBalances[addr] = addressToBalances[addr].Add(denomOnly)
+		}
+	}
+
+	// All the legs succeeded, so commit them together.
+	writeCache()
+	return addressToBalances, nil
+}
+
 func marshal(event vm.Jsonable) ([]byte, error) {
 	if event == nil {
 		return nil, nil
@@ -151,6 +392,76 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			}
 		}
 
+	case "VBANK_GET_BALANCES":
+		queries := msg.Queries
+		if msg.Address != "" {
+			queries = append(queries, vbankBalanceQuery{Address: msg.Address})
+		}
+		bz, err := json.Marshal(getBalances(ctx, keeper, queries))
+		if err != nil {
+			return "", err
+		}
+		ret = string(bz)
+
+	case "VBANK_GET_DENOM_METADATA":
+		if err = sdk.ValidateDenom(msg.Denom); err != nil {
+			return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
+		}
+		result := vbankDenomMetadata{
+			Denom:    msg.Denom,
+			Metadata: json.RawMessage("null"),
+		}
+		if metadata, found := keeper.GetDenomMetadata(ctx, msg.Denom); found {
+			result.Metadata, err = types.ModuleCdc.MarshalJSON(&metadata)
+			if err != nil {
+				return "", err
+			}
+		}
+		trace, found, err := keeper.GetDenomTrace(ctx, msg.Denom)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			result.Trace = &vbankDenomTrace{Path: trace.Path, BaseDenom: trace.BaseDenom}
+		}
+		bz, err := json.Marshal(&result)
+		if err != nil {
+			return "", err
+		}
+		ret = string(bz)
+
+	case "VBANK_SET_DENOM_METADATA":
+		var metadata banktypes.Metadata
+		if err = types.ModuleCdc.UnmarshalJSON(msg.Metadata, &metadata); err != nil {
+			return "", fmt.Errorf("cannot decode denom metadata: %s", err)
+		}
+		if err = keeper.SetDenomMetadata(ctx, metadata); err != nil {
+			return "", fmt.Errorf("cannot set denom metadata: %s", err)
+		}
+		ret = "true"
+
+	case "VBANK_LAST_SEQUENCE":
+		bz, err := json.Marshal(keeper.GetState(ctx).LastSequence)
+		if err != nil {
+			return "", err
+		}
+		ret = string(bz)
+
+	case "VBANK_RESYNC":
+		queries := msg.Queries
+		for _, address := range msg.Addresses {
+			queries = append(queries, vbankBalanceQuery{Address: address})
+		}
+		resync, err := getResync(ctx, keeper, queries)
+		if err != nil {
+			return "", err
+		}
+		bz, err := json.Marshal(&resync)
+		if err != nil {
+			return "", err
+		}
+		ret = string(bz)
+
 	case "VBANK_GRAB":
 		addr, err := sdk.AccAddressFromBech32(msg.Sender)
 		if err != nil {
@@ -164,6 +475,9 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
 		}
 		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, value))
+		if err := keeper.CheckGrabAllowed(ctx, coins); err != nil {
+			return "", err
+		}
 		if err := keeper.GrabCoins(ctx, addr, coins); err != nil {
 			return "", fmt.Errorf("cannot grab %s coins: %s", coins.Sort().String(), err)
 		}
@@ -192,9 +506,13 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
 		}
 		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, value))
+		if err := keeper.CheckGiveAllowed(ctx, coins); err != nil {
+			return "", err
+		}
 		if err := keeper.SendCoins(ctx, addr, coins); err != nil {
 			return "", fmt.Errorf("cannot give %s coins: %s", coins.Sort().String(), err)
 		}
+		keeper.RecordGive(ctx, coins)
 		addressToBalances := make(map[string]sdk.Coins, 1)
 		addressToBalances[msg.Recipient] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
 		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
@@ -207,6 +525,21 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			ret = string(bz)
 		}
 
+	case "VBANK_TRANSFER_BATCH":
+		addressToBalances, err := transferBatch(ctx, keeper, msg.Transfers)
+		if err != nil {
+			return "", err
+		}
+		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
+		if err != nil {
+			return "", err
+		}
+		if bz == nil {
+			ret = "true"
+		} else {
+			ret = string(bz)
+		}
+
 	case "VBANK_GIVE_TO_REWARD_DISTRIBUTOR":
 		value, ok := sdk.NewIntFromString(msg.Amount)
 		if !ok {
@@ -225,6 +558,23 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		// We don't supply the module balance, since the controller shouldn't know.
 		ret = "true"
 
+	case "VBANK_SUBSCRIBE", "VBANK_UNSUBSCRIBE":
+		addr, err := sdk.AccAddressFromBech32(msg.Address)
+		if err != nil {
+			return "", fmt.Errorf("cannot convert %s to address: %s", msg.Address, err)
+		}
+		if msg.Denom != "" {
+			if err = sdk.ValidateDenom(msg.Denom); err != nil {
+				return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
+			}
+		}
+		if msg.Type == "VBANK_SUBSCRIBE" {
+			keeper.Subscribe(ctx, addr, msg.Denom)
+		} else {
+			keeper.Unsubscribe(ctx, addr, msg.Denom)
+		}
+		ret = "true"
+
 	case "VBANK_GET_MODULE_ACCOUNT_ADDRESS":
 		addr := keeper.GetModuleAccountAddress(ctx, msg.ModuleName).String()
 		if len(addr) == 0 {
@@ -1,6 +1,7 @@
 package vbank
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -9,15 +10,22 @@ import (
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -195,9 +203,17 @@ type mockBank struct {
 	calls []string
 	// balances for each address
 	balances map[string]sdk.Coins
+	// error returned by FundCommunityPool
+	fundCommunityPoolErr error
+	// metadata for each denom
+	metadata map[string]banktypes.Metadata
+	// IBC denom traces
+	denomTraces []ibctransfertypes.DenomTrace
 }
 
 var _ types.BankKeeper = (*mockBank)(nil)
+var _ types.DistributionKeeper = (*mockBank)(nil)
+var _ types.TransferKeeper = (*mockBank)(nil)
 
 func (b *mockBank) record(s string) {
 	b.calls = append(b.calls, s)
@@ -208,6 +224,11 @@ func (b *mockBank) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	return nil
 }
 
+func (b *mockBank) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	b.record(fmt.Sprintf("FundCommunityPool %s %s", sender, amount))
+	return b.fundCommunityPoolErr
+}
+
 func (b *mockBank) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	b.record(fmt.Sprintf("GetAllBalances %s", addr))
 	balances, ok := b.balances[addr.String()]
@@ -226,11 +247,34 @@ func (b *mockBank) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string
 	return sdk.NewCoin(denom, amount)
 }
 
+func (b *mockBank) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	b.record(fmt.Sprintf("GetDenomMetaData %s", denom))
+	metadata, ok := b.metadata[denom]
+	return metadata, ok
+}
+
+func (b *mockBank) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	for _, trace := range b.denomTraces {
+		if bytes.Equal(trace.Hash(), denomTraceHash) {
+			return trace, true
+		}
+	}
+	return ibctransfertypes.DenomTrace{}, false
+}
+
 func (b *mockBank) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
 	b.record(fmt.Sprintf("MintCoins %s %s", moduleName, amt))
 	return nil
 }
 
+func (b *mockBank) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	b.record(fmt.Sprintf("SendCoins %s %s %s", fromAddr, toAddr, amt))
+	if !b.balances[fromAddr.String()].IsAllGTE(amt) {
+		return fmt.Errorf("insufficient funds")
+	}
+	return nil
+}
+
 func (b *mockBank) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	b.record(fmt.Sprintf("SendCoinsFromAccountToModule %s %s %s", senderAddr, recipientModule, amt))
 	return nil
@@ -246,6 +290,14 @@ func (b *mockBank) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, re
 	return nil
 }
 
+func (b *mockBank) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {
+	b.record(fmt.Sprintf("SetDenomMetaData %s", denomMetaData.Base))
+	if b.metadata == nil {
+		b.metadata = map[string]banktypes.Metadata{}
+	}
+	b.metadata[denomMetaData.Base] = denomMetaData
+}
+
 // makeTestKit creates a minimal Keeper and Context for use in testing.
 func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sdk.Context) {
 	encodingConfig := params.MakeEncodingConfig()
@@ -259,7 +311,9 @@ func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sd
 	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
 
 	subspace := pk.Subspace(types.ModuleName)
-	keeper := NewKeeper(cdc, vbankStoreKey, subspace, account, bank, "feeCollectorName", pushAction)
+	distribution, _ := bank.(types.DistributionKeeper)
+	transfer, _ := bank.(types.TransferKeeper)
+	keeper := NewKeeper(cdc, vbankStoreKey, subspace, account, bank, distribution, transfer, "feeCollectorName", pushAction)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -306,6 +360,59 @@ func Test_Receive_GetBalance(t *testing.T) {
 	}
 }
 
+func Test_Receive_GetBalances(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("quatloos", 123), sdk.NewInt64Coin("ubld", 4)),
+		addr2: sdk.NewCoins(sdk.NewInt64Coin("urun", 5)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	ret, err := ch.Receive(ctlCtx, `{
+		"type": "VBANK_GET_BALANCES",
+		"address": "`+addr1+`",
+		"queries": [
+			{"address": "`+addr2+`", "denom": "urun"},
+			{"address": "`+addr2+`", "denom": "ubld"},
+			{"address": "`+addr1+`", "denom": "quatloos"},
+			{"address": "foo", "denom": "ubld"},
+			{"address": "`+addr2+`", "denom": "?"}
+		]
+		}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+
+	var got []vbankBalanceResult
+	if err := json.Unmarshal([]byte(ret), &got); err != nil {
+		t.Fatalf("cannot decode %s: %v", ret, err)
+	}
+	want := []vbankBalanceResult{
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: addr1, Denom: "quatloos", Amount: "123"}},
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: addr1, Denom: "ubld", Amount: "4"}},
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: addr2, Denom: "?"}},
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: addr2, Denom: "ubld", Amount: "0"}},
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: addr2, Denom: "urun", Amount: "5"}},
+		{vbankSingleBalanceUpdate: vbankSingleBalanceUpdate{Address: "foo", Denom: "ubld"}},
+	}
+	sort.SliceStable(want, func(i, j int) bool {
+		return vbankManyBalanceUpdates{want[i].vbankSingleBalanceUpdate, want[j].vbankSingleBalanceUpdate}.Less(0, 1)
+	})
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].vbankSingleBalanceUpdate != want[i].vbankSingleBalanceUpdate {
+			t.Errorf("got result %d %v, want %v", i, got[i], want[i])
+		}
+		wantError := want[i].Amount == ""
+		if (got[i].Error != "") != wantError {
+			t.Errorf("got result %d error %q, want error %v", i, got[i].Error, wantError)
+		}
+	}
+}
+
 func Test_Receive_Give(t *testing.T) {
 	bank := &mockBank{balances: map[string]sdk.Coins{
 		addr1: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
@@ -345,6 +452,108 @@ func Test_Receive_Give(t *testing.T) {
 	}
 }
 
+func Test_Receive_TransferBatch(t *testing.T) {
+	moduleBech32 := "cosmos1ae0lmtzlgrcnla9xjkpaarq5d5dfez639v3rgf"
+	// Other module accounts exist and hold funds, but must not be transfer parties.
+	bondedPool := authtypes.NewEmptyModuleAccount("bonded_tokens_pool")
+	vbankModule := authtypes.NewEmptyModuleAccount("vbank")
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{
+			moduleBech32:                      authtypes.NewEmptyModuleAccount("vbank/reserve"),
+			bondedPool.GetAddress().String():  bondedPool,
+			vbankModule.GetAddress().String(): vbankModule,
+		},
+		modAddrs: map[string]string{
+			"vbank/reserve":      moduleBech32,
+			"bonded_tokens_pool": bondedPool.GetAddress().String(),
+			"vbank":              vbankModule.GetAddress().String(),
+		},
+	}
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1:                             sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+		addr2:                             sdk.NewCoins(sdk.NewInt64Coin("ubld", 5), sdk.NewInt64Coin("urun", 100)),
+		moduleBech32:                      sdk.NewCoins(sdk.NewInt64Coin("ubld", 20)),
+		bondedPool.GetAddress().String():  sdk.NewCoins(sdk.NewInt64Coin("ubld", 20)),
+		vbankModule.GetAddress().String(): sdk.NewCoins(sdk.NewInt64Coin("urun", 20)),
+	}}
+	keeper, ctx := makeTestKit(acct, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	ret, err := ch.Receive(ctlCtx, `{
+		"type": "VBANK_TRANSFER_BATCH",
+		"transfers": [
+			{"sender": "`+addr1+`", "recipient": "`+addr2+`", "denom": "urun", "amount": "100"},
+			{"sender": "`+addr2+`", "recipientModule": "vbank/reserve", "denom": "ubld", "amount": "5"}
+		]
+		}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	want := newBalances(
+		account(addr1, coin("urun", "1000")),
+		account(addr2, coin("ubld", "5"), coin("urun", "100")),
+		account(moduleBech32, coin("ubld", "20")),
+	)
+	got, gotNonce, err := decodeBalances([]byte(ret))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	nonce := uint64(1)
+	if gotNonce != nonce {
+		t.Errorf("got nonce %+v, want %+v", gotNonce, nonce)
+	}
+	wantCalls := []string{
+		"SendCoins " + addr1 + " " + addr2 + " 100urun",
+		"SendCoins " + addr2 + " " + moduleBech32 + " 5ubld",
+	}
+	if !reflect.DeepEqual(bank.calls[:len(wantCalls)], wantCalls) {
+		t.Errorf("got calls %v, want {%s}", bank.calls, wantCalls)
+	}
+
+	// A failing leg aborts the whole batch without a balance update.
+	bank.calls = nil
+	_, err = ch.Receive(ctlCtx, `{
+		"type": "VBANK_TRANSFER_BATCH",
+		"transfers": [
+			{"sender": "`+addr1+`", "recipient": "`+addr2+`", "denom": "urun", "amount": "100"},
+			{"sender": "`+addr2+`", "recipient": "`+addr1+`", "denom": "ubld", "amount": "6"}
+		]
+		}`)
+	if err == nil {
+		t.Fatalf("got no error for an overdrawn leg")
+	}
+	wantCalls = []string{
+		"SendCoins " + addr1 + " " + addr2 + " 100urun",
+		"SendCoins " + addr2 + " " + addr1 + " 6ubld",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want {%s}", bank.calls, wantCalls)
+	}
+
+	for _, bad := range []string{
+		`[]`,
+		`[{"sender": "` + addr1 + `", "senderModule": "vbank/reserve", "recipient": "` + addr2 + `", "denom": "urun", "amount": "1"}]`,
+		`[{"senderModule": "nosuch", "recipient": "` + addr2 + `", "denom": "urun", "amount": "1"}]`,
+		`[{"senderModule": "bonded_tokens_pool", "recipient": "` + addr2 + `", "denom": "ubld", "amount": "1"}]`,
+		`[{"sender": "` + addr2 + `", "recipientModule": "bonded_tokens_pool", "denom": "ubld", "amount": "1"}]`,
+		`[{"senderModule": "vbank", "recipient": "` + addr2 + `", "denom": "urun", "amount": "1"}]`,
+		`[{"sender": "` + addr2 + `", "recipientModule": "vbank", "denom": "urun", "amount": "1"}]`,
+		`[{"sender": "` + bondedPool.GetAddress().String() + `", "recipient": "` + addr2 + `", "denom": "ubld", "amount": "1"}]`,
+		`[{"sender": "` + addr2 + `", "recipient": "` + bondedPool.GetAddress().String() + `", "denom": "ubld", "amount": "1"}]`,
+		`[{"sender": "` + vbankModule.GetAddress().String() + `", "recipient": "` + addr2 + `", "denom": "urun", "amount": "1"}]`,
+		`[{"sender": "` + addr2 + `", "recipient": "` + vbankModule.GetAddress().String() + `", "denom": "urun", "amount": "1"}]`,
+		`[{"sender": "` + addr1 + `", "recipient": "` + addr2 + `", "denom": "urun", "amount": "0"}]`,
+	} {
+		if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_TRANSFER_BATCH", "transfers": `+bad+`}`); err == nil {
+			t.Errorf("got no error for transfers %s", bad)
+		}
+	}
+}
+
 func Test_Receive_GiveToRewardDistributor(t *testing.T) {
 	bank := &mockBank{}
 	keeper, ctx := makeTestKit(nil, bank)
@@ -468,6 +677,575 @@ func Test_Receive_GiveToRewardDistributor(t *testing.T) {
 	}
 }
 
+func Test_DistributeRewards_Destinations(t *testing.T) {
+	reserve := authtypes.NewEmptyModuleAccount(types.ReservePoolName)
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{reserve.GetAddress().String(): reserve},
+		modAddrs: map[string]string{types.ReservePoolName: reserve.GetAddress().String()},
+	}
+	bank := &mockBank{}
+	keeper, ctx := makeTestKit(acct, bank)
+
+	params := types.DefaultParams()
+	params.RewardDestinations = []types.RewardDestination{
+		{Kind: types.RewardDestinationFeeCollector, Weight: sdk.NewDec(2)},
+		{Kind: types.RewardDestinationModuleAccount, ModuleName: types.ReservePoolName, Weight: sdk.NewDec(1)},
+	}
+	if err := params.ValidateBasic(); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	keeper.SetParams(ctx, params)
+	keeper.SetState(ctx, types.State{
+		RewardPool: sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+		RewardDestinationTotals: []types.RewardDestinationTotal{
+			{Destination: types.RewardDestinationFeeCollector, Distributed: sdk.NewCoins(sdk.NewInt64Coin("urun", 1))},
+		},
+	})
+
+	if err := keeper.DistributeRewards(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantCalls := []string{
+		"SendCoinsFromModuleToModule vbank feeCollectorName 66urun",
+		"SendCoinsFromModuleToModule vbank vbank/reserve 34urun",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want %v", bank.calls, wantCalls)
+	}
+	state := keeper.GetState(ctx)
+	wantTotals := []types.RewardDestinationTotal{
+		{Destination: types.RewardDestinationFeeCollector, Distributed: sdk.NewCoins(sdk.NewInt64Coin("urun", 67))},
+		{Destination: types.RewardDestinationModuleAccount + ":" + types.ReservePoolName, Distributed: sdk.NewCoins(sdk.NewInt64Coin("urun", 34))},
+	}
+	if !reflect.DeepEqual(state.RewardDestinationTotals, wantTotals) {
+		t.Errorf("got totals %v, want %v", state.RewardDestinationTotals, wantTotals)
+	}
+	if !state.RewardPool.IsZero() {
+		t.Errorf("got reward pool %v, want empty", state.RewardPool)
+	}
+
+	// An unregistered module account is refused rather than paid, and its share
+	// stays in the pool.
+	params.RewardDestinations = []types.RewardDestination{
+		{Kind: types.RewardDestinationModuleAccount, ModuleName: types.GiveawayPoolName, Weight: sdk.NewDec(1)},
+	}
+	keeper.SetParams(ctx, params)
+	wantPool := sdk.NewCoins(sdk.NewInt64Coin("urun", 100))
+	keeper.SetState(ctx, types.State{RewardPool: wantPool})
+	bank.calls = nil
+	if err := keeper.DistributeRewards(ctx); err != nil {
+		t.Errorf("got error = %v", err)
+	}
+	if len(bank.calls) != 0 {
+		t.Errorf("got calls %v, want none", bank.calls)
+	}
+	if state := keeper.GetState(ctx); !state.RewardPool.IsEqual(wantPool) {
+		t.Errorf("got reward pool %s, want %s", state.RewardPool, wantPool)
+	}
+
+	// Only the vbank pools can be module account reward destinations.
+	for _, name := range []string{
+		stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName,
+		distrtypes.ModuleName, types.ModuleName,
+	} {
+		params.RewardDestinations = []types.RewardDestination{
+			{Kind: types.RewardDestinationModuleAccount, ModuleName: name, Weight: sdk.NewDec(1)},
+		}
+		if err := params.ValidateBasic(); err == nil {
+			t.Errorf("got no error for reward destination %s", name)
+		}
+	}
+}
+
+func Test_DistributeRewards_FailedDestination(t *testing.T) {
+	vbankModule := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{vbankModule.GetAddress().String(): vbankModule},
+		modAddrs: map[string]string{types.ModuleName: vbankModule.GetAddress().String()},
+	}
+	bank := &mockBank{fundCommunityPoolErr: fmt.Errorf("community pool unavailable")}
+	keeper, ctx := makeTestKit(acct, bank)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	params := types.DefaultParams()
+	params.RewardDestinations = []types.RewardDestination{
+		{Kind: types.RewardDestinationFeeCollector, Weight: sdk.NewDec(2)},
+		{Kind: types.RewardDestinationCommunityPool, Weight: sdk.NewDec(1)},
+	}
+	keeper.SetParams(ctx, params)
+	keeper.SetState(ctx, types.State{RewardPool: sdk.NewCoins(sdk.NewInt64Coin("urun", 100))})
+
+	if err := keeper.DistributeRewards(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantCalls := []string{
+		"SendCoinsFromModuleToModule vbank feeCollectorName 66urun",
+		"FundCommunityPool " + vbankModule.GetAddress().String() + " 34urun",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want %v", bank.calls, wantCalls)
+	}
+
+	// The fee collector is still paid, and the failed share stays in the pool.
+	state := keeper.GetState(ctx)
+	wantPool := sdk.NewCoins(sdk.NewInt64Coin("urun", 34))
+	wantTotals := []types.RewardDestinationTotal{
+		{Destination: types.RewardDestinationFeeCollector, Distributed: sdk.NewCoins(sdk.NewInt64Coin("urun", 66))},
+	}
+	if !state.RewardPool.IsEqual(wantPool) || !reflect.DeepEqual(state.RewardDestinationTotals, wantTotals) {
+		t.Errorf("got state %v, want pool %s and totals %v", state, wantPool, wantTotals)
+	}
+	history := keeper.GetRewardHistory(ctx)
+	wantDistributed := sdk.NewCoins(sdk.NewInt64Coin("urun", 66))
+	if len(history) != 1 || !history[0].Distributed.IsEqual(wantDistributed) {
+		t.Errorf("got history %v, want one epoch with %s distributed", history, wantDistributed)
+	}
+
+	events := ctx.EventManager().Events()
+	if len(events) != 1 || events[0].Type != proto.MessageName(&types.EventRewardDestinationFailed{}) {
+		t.Fatalf("got events %v, want one EventRewardDestinationFailed", events)
+	}
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	want := &types.EventRewardDestinationFailed{
+		Destination: types.RewardDestinationCommunityPool,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("urun", 34)),
+		Error:       "community pool unavailable",
+	}
+	if !reflect.DeepEqual(msg, want) {
+		t.Errorf("got event %v, want %v", msg, want)
+	}
+}
+
+func Test_RewardHistory(t *testing.T) {
+	bank := &mockBank{}
+	keeper, ctx := makeTestKit(nil, bank)
+
+	params := types.DefaultParams()
+	params.RewardEpochDurationBlocks = 4
+	params.RewardSmoothingBlocks = 2
+	params.PerEpochRewardFraction = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+	keeper.SetState(ctx, types.State{RewardPool: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000))})
+
+	for height := int64(1); height <= 8; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		if err := keeper.DistributeRewards(ctx); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+
+	// The first epoch starts at block 4 with half the pool at 250urun per block,
+	// distributed in the following block. The second epoch starts at block 8
+	// with half the remaining pool, and has distributed nothing yet.
+	wantHistory := []types.RewardEpoch{
+		{
+			StartBlock:        4,
+			RewardBlockAmount: sdk.NewCoins(sdk.NewInt64Coin("urun", 250)),
+			Distributed:       sdk.NewCoins(sdk.NewInt64Coin("urun", 250)),
+		},
+		{
+			StartBlock:        8,
+			RewardBlockAmount: sdk.NewCoins(sdk.NewInt64Coin("urun", 188)),
+		},
+	}
+	gotHistory, err := keeper.RewardHistory(sdk.WrapSDKContext(ctx), &types.QueryRewardHistoryRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !reflect.DeepEqual(gotHistory.Epochs, wantHistory) {
+		t.Errorf("got history %v, want %v", gotHistory.Epochs, wantHistory)
+	}
+
+	gotPosition, err := keeper.EpochPosition(sdk.WrapSDKContext(ctx), &types.QueryEpochPositionRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantPosition := &types.QueryEpochPositionResponse{
+		BlockHeight:         8,
+		EpochStartBlock:     8,
+		NextEpochBlock:      12,
+		SmoothingBlocksLeft: 1,
+	}
+	if !reflect.DeepEqual(gotPosition, wantPosition) {
+		t.Errorf("got position %v, want %v", gotPosition, wantPosition)
+	}
+
+	// The history is bounded.
+	params.RewardEpochDurationBlocks = 0
+	params.RewardSmoothingBlocks = 1
+	keeper.SetParams(ctx, params)
+	for height := int64(9); height <= 200; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		if err := keeper.DistributeRewards(ctx); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	gotHistory, err = keeper.RewardHistory(sdk.WrapSDKContext(ctx), &types.QueryRewardHistoryRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(gotHistory.Epochs) != 100 {
+		t.Fatalf("got %d epochs, want 100", len(gotHistory.Epochs))
+	}
+	if start := gotHistory.Epochs[0].StartBlock; start != 101 {
+		t.Errorf("got oldest epoch start %d, want 101", start)
+	}
+
+	// An epoch with nothing to distribute is not recorded.
+	state := keeper.GetState(ctx)
+	state.RewardPool = sdk.NewCoins()
+	keeper.SetState(ctx, state)
+	ctx = ctx.WithBlockHeight(201)
+	if err := keeper.DistributeRewards(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	history := keeper.GetRewardHistory(ctx)
+	if len(history) != 100 || history[99].StartBlock != 200 {
+		t.Errorf("got %d epochs ending at %d, want 100 ending at 200", len(history), history[len(history)-1].StartBlock)
+	}
+}
+
+func Test_Invariants(t *testing.T) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		moduleAddr: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+	}}
+	k, ctx := makeTestKit(nil, bank)
+	params := types.DefaultParams()
+	params.RewardSmoothingBlocks = 10
+	k.SetParams(ctx, params)
+	k.SetState(ctx, types.State{RewardPool: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000))})
+	if err := k.DistributeRewards(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	k.GetNextSequence(ctx)
+
+	invariant := keeper.AllInvariants(k)
+	if msg, broken := invariant(ctx); broken {
+		t.Fatalf("got broken invariant: %s", msg)
+	}
+
+	// The pool exceeds the module account balance, and the block amount then
+	// exceeds the epoch pool once the pool is repaired.
+	state := k.GetState(ctx)
+	state.RewardPool = sdk.NewCoins(sdk.NewInt64Coin("urun", 2000))
+	state.RewardBlockAmount = sdk.NewCoins(sdk.NewInt64Coin("urun", 1500))
+	k.SetState(ctx, state)
+	if _, broken := keeper.RewardPoolInvariant(k)(ctx); !broken {
+		t.Errorf("got reward pool invariant not broken")
+	}
+	k.RepairRewardPool(ctx)
+	if msg, broken := invariant(ctx); broken {
+		t.Errorf("got broken invariant after repair: %s", msg)
+	}
+	state = k.GetState(ctx)
+	wantPool := sdk.NewCoins(sdk.NewInt64Coin("urun", 1000))
+	wantBlockAmount := sdk.NewCoins(sdk.NewInt64Coin("urun", 1100))
+	if !state.RewardPool.IsEqual(wantPool) || !state.RewardBlockAmount.IsEqual(wantBlockAmount) {
+		t.Errorf("got repaired state %v, want pool %s and block amount %s", state, wantPool, wantBlockAmount)
+	}
+
+	// The sequence must not go back below the highest one issued, however often
+	// the invariant is checked.
+	state.LastSequence = 0
+	k.SetState(ctx, state)
+	for i := 0; i < 2; i++ {
+		if _, broken := keeper.LastSequenceInvariant(k)(ctx); !broken {
+			t.Errorf("got last sequence invariant not broken")
+		}
+	}
+}
+
+func Test_RewardDestinations_Validation(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		destinations []types.RewardDestination
+	}{
+		{"unknown kind", []types.RewardDestination{{Kind: "burn", Weight: sdk.OneDec()}}},
+		{"zero weight", []types.RewardDestination{{Kind: types.RewardDestinationCommunityPool, Weight: sdk.ZeroDec()}}},
+		{"nil weight", []types.RewardDestination{{Kind: types.RewardDestinationCommunityPool}}},
+		{"missing module name", []types.RewardDestination{{Kind: types.RewardDestinationModuleAccount, Weight: sdk.OneDec()}}},
+		{"extra module name", []types.RewardDestination{{Kind: types.RewardDestinationFeeCollector, ModuleName: "vbank", Weight: sdk.OneDec()}}},
+		{"duplicate", []types.RewardDestination{
+			{Kind: types.RewardDestinationCommunityPool, Weight: sdk.OneDec()},
+			{Kind: types.RewardDestinationCommunityPool, Weight: sdk.OneDec()},
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RewardDestinations = tt.destinations
+			if err := params.ValidateBasic(); err == nil {
+				t.Errorf("got no error for %v", tt.destinations)
+			}
+		})
+	}
+}
+
+func Test_Receive_DenomMetadata(t *testing.T) {
+	atomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	bank := &mockBank{denomTraces: []ibctransfertypes.DenomTrace{atomTrace}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	istMetadata := `{
+		"description": "Inter Stable Token",
+		"denom_units": [
+			{"denom": "uist", "exponent": 0},
+			{"denom": "IST", "exponent": 6}
+		],
+		"base": "uist",
+		"display": "IST",
+		"name": "IST",
+		"symbol": "IST"
+	}`
+	setMetadata := `{"type": "VBANK_SET_DENOM_METADATA", "metadata": ` + istMetadata + `}`
+	if _, err := ch.Receive(ctlCtx, setMetadata); err == nil {
+		t.Errorf("got no error setting metadata without governance approval")
+	}
+
+	params := types.DefaultParams()
+	params.SettableMetadataDenoms = []string{"uist"}
+	keeper.SetParams(ctx, params)
+	ret, err := ch.Receive(ctlCtx, setMetadata)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "true" {
+		t.Errorf("got %v, want \"true\"", ret)
+	}
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_SET_DENOM_METADATA", "metadata": {"base": "uist"}}`); err == nil {
+		t.Errorf("got no error setting invalid metadata")
+	}
+
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "uist"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	var got vbankDenomMetadata
+	if err := json.Unmarshal([]byte(ret), &got); err != nil {
+		t.Fatalf("cannot decode %s: %v", ret, err)
+	}
+	var gotMetadata banktypes.Metadata
+	if err := types.ModuleCdc.UnmarshalJSON(got.Metadata, &gotMetadata); err != nil {
+		t.Fatalf("cannot decode metadata %s: %v", got.Metadata, err)
+	}
+	if got.Denom != "uist" || gotMetadata.Display != "IST" || len(gotMetadata.DenomUnits) != 2 || got.Trace != nil {
+		t.Errorf("got %s, want IST metadata", ret)
+	}
+
+	ibcDenom := atomTrace.IBCDenom()
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "`+ibcDenom+`"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	want := `{"denom":"` + ibcDenom + `","metadata":null,"trace":{"path":"transfer/channel-0","baseDenom":"uatom"}}`
+	if ret != want {
+		t.Errorf("got %s, want %s", ret, want)
+	}
+
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "ibc/nothex"}`); err == nil {
+		t.Errorf("got no error for an invalid IBC denom")
+	}
+}
+
+func Test_Receive_DenomAllowlist(t *testing.T) {
+	vbankModule := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	vbankAddr := vbankModule.GetAddress().String()
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{vbankAddr: vbankModule},
+		modAddrs: map[string]string{types.ModuleName: vbankAddr},
+	}
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1:     sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000), sdk.NewInt64Coin("urun", 1000)),
+		vbankAddr: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+	}}
+	keeper, ctx := makeTestKit(acct, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctx = ctx.WithBlockHeight(10)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.DenomAllowances = []types.DenomAllowance{
+		{Denom: "ubld", Grab: true, GiveLimitPerBlock: sdk.ZeroInt()},
+		{Denom: "urun", Give: true, Grab: true, GiveLimitPerBlock: sdk.NewInt(150)},
+	}
+	if err := params.ValidateBasic(); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	keeper.SetParams(ctx, params)
+
+	give := func(denom, amount string) error {
+		_, err := ch.Receive(ctlCtx, `{"type": "VBANK_GIVE", "recipient": "`+addr1+`", "denom": "`+denom+`", "amount": "`+amount+`"}`)
+		return err
+	}
+	grab := func(denom, amount string) error {
+		_, err := ch.Receive(ctlCtx, `{"type": "VBANK_GRAB", "sender": "`+addr1+`", "denom": "`+denom+`", "amount": "`+amount+`"}`)
+		return err
+	}
+
+	if err := give("urun", "100"); err != nil {
+		t.Errorf("got error = %v", err)
+	}
+	if err := give("urun", "51"); err == nil || err.Error() != "VBANK_GIVE of 51urun exceeds the remaining block limit (50 of 150urun)" {
+		t.Errorf("got error %v for give over the block limit", err)
+	}
+	if err := give("ubld", "1"); err == nil || err.Error() != "denom ubld is not allowed for VBANK_GIVE" {
+		t.Errorf("got error %v for disallowed give", err)
+	}
+	if err := give("uist", "1"); err == nil {
+		t.Errorf("got no error for give of an unlisted denom")
+	}
+	if err := grab("ubld", "1"); err != nil {
+		t.Errorf("got error = %v", err)
+	}
+	if err := grab("uist", "1"); err == nil || err.Error() != "denom uist is not allowed for VBANK_GRAB" {
+		t.Errorf("got error %v for grab of an unlisted denom", err)
+	}
+
+	res, err := keeper.DenomAllowlist(ctlCtx, &types.QueryDenomAllowlistRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantGiven := sdk.NewCoins(sdk.NewInt64Coin("urun", 100))
+	if !res.Restricted || len(res.Allowances) != 2 || !res.GivenThisBlock.IsEqual(wantGiven) {
+		t.Errorf("got allowlist %v, want restricted with given %s", res, wantGiven)
+	}
+
+	// Transfer legs cannot bypass the allowances through the vbank module account.
+	transfer := func(sender, recipient string) error {
+		_, err := ch.Receive(ctlCtx, `{"type": "VBANK_TRANSFER_BATCH", "transfers": [{"sender": "`+sender+`", "recipient": "`+recipient+`", "denom": "urun", "amount": "1"}]}`)
+		return err
+	}
+	if err := transfer(vbankAddr, addr1); err == nil {
+		t.Errorf("got no error for transfer out of the vbank module account")
+	}
+	if err := transfer(addr1, vbankAddr); err == nil {
+		t.Errorf("got no error for transfer into the vbank module account")
+	}
+
+	// A give in a discarded context is not counted.
+	cacheCtx, _ := ctx.CacheContext()
+	keeper.RecordGive(cacheCtx, sdk.NewCoins(sdk.NewInt64Coin("urun", 50)))
+	res, err = keeper.DenomAllowlist(ctlCtx, &types.QueryDenomAllowlistRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.GivenThisBlock.IsEqual(wantGiven) {
+		t.Errorf("got given %s after a discarded give, want %s", res.GivenThisBlock, wantGiven)
+	}
+
+	// The limit is per block.
+	ctx = ctx.WithBlockHeight(11)
+	ctlCtx = sdk.WrapSDKContext(ctx)
+	res, err = keeper.DenomAllowlist(ctlCtx, &types.QueryDenomAllowlistRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.GivenThisBlock.IsZero() {
+		t.Errorf("got given %s in a new block, want none", res.GivenThisBlock)
+	}
+	if err := give("urun", "150"); err != nil {
+		t.Errorf("got error = %v", err)
+	}
+}
+
+func Test_Receive_Resync(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+		addr2: sdk.NewCoins(sdk.NewInt64Coin("ubld", 5), sdk.NewInt64Coin("urun", 7)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_LAST_SEQUENCE"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "0" {
+		t.Errorf("got last sequence %s, want 0", ret)
+	}
+
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_GIVE", "recipient": "`+addr1+`", "amount": "1000", "denom": "urun"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_LAST_SEQUENCE"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "1" {
+		t.Errorf("got last sequence %s, want 1", ret)
+	}
+	res, err := keeper.LastSequence(ctlCtx, &types.QueryLastSequenceRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if res.LastSequence != 1 {
+		t.Errorf("got queried last sequence %d, want 1", res.LastSequence)
+	}
+
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_RESYNC", "addresses": ["`+addr2+`", "`+addr1+`", "`+addr2+`", "`+addr3+`"]}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	var got vbankResync
+	if err := json.Unmarshal([]byte(ret), &got); err != nil {
+		t.Fatalf("cannot decode %s: %v", ret, err)
+	}
+	want := vbankResync{
+		Nonce: 1,
+		Updated: vbankManyBalanceUpdates{
+			{Address: addr1, Denom: "urun", Amount: "1000"},
+			{Address: addr2, Denom: "ubld", Amount: "5"},
+			{Address: addr2, Denom: "urun", Amount: "7"},
+		},
+	}
+	sort.Sort(want.Updated)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// A queried denom whose balance dropped to zero is still reported.
+	bank.balances[addr2] = sdk.NewCoins(sdk.NewInt64Coin("urun", 7))
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_RESYNC", "queries": [
+		{"address": "`+addr2+`", "denom": "ubld"},
+		{"address": "`+addr2+`", "denom": "urun"}
+	], "addresses": ["`+addr2+`"]}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	got = vbankResync{}
+	if err := json.Unmarshal([]byte(ret), &got); err != nil {
+		t.Fatalf("cannot decode %s: %v", ret, err)
+	}
+	want = vbankResync{
+		Nonce: 1,
+		Updated: vbankManyBalanceUpdates{
+			{Address: addr2, Denom: "ubld", Amount: "0"},
+			{Address: addr2, Denom: "urun", Amount: "7"},
+		},
+	}
+	sort.Sort(want.Updated)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	for _, bad := range []string{
+		`"addresses": ["foo"]`,
+		`"queries": [{"address": "foo", "denom": "urun"}]`,
+		`"queries": [{"address": "` + addr1 + `", "denom": "!"}]`,
+	} {
+		if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_RESYNC", `+bad+`}`); err == nil {
+			t.Errorf("got no error for %s", bad)
+		}
+	}
+}
+
 func Test_Receive_Grab(t *testing.T) {
 	bank := &mockBank{balances: map[string]sdk.Coins{
 		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000)),
@@ -618,6 +1396,101 @@ func Test_EndBlock_Events(t *testing.T) {
 	}
 }
 
+func Test_EndBlock_Subscriptions(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr3: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000), sdk.NewInt64Coin("urun", 7)),
+		addr4: sdk.NewCoins(sdk.NewInt64Coin("ubld", 2000)),
+	}}
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{
+			addr3: &authtypes.BaseAccount{Address: addr3},
+			addr4: &authtypes.BaseAccount{Address: addr4},
+		},
+	}
+	keeper, ctx := makeTestKit(acct, bank)
+	// Turn off rewards.
+	keeper.SetParams(ctx, types.Params{PerEpochRewardFraction: sdk.ZeroDec()})
+	msgsSent := []string{}
+	keeper.PushAction = func(ctx sdk.Context, action vm.Action) error {
+		bz, err := json.Marshal(action)
+		if err != nil {
+			return err
+		}
+		msgsSent = append(msgsSent, string(bz))
+		return nil
+	}
+	am := NewAppModule(keeper)
+	ch := NewPortHandler(am, keeper)
+
+	for _, msg := range []string{
+		`{"type": "VBANK_SUBSCRIBE", "address": "` + addr3 + `", "denom": "urun"}`,
+		`{"type": "VBANK_SUBSCRIBE", "address": "` + addr4 + `", "denom": ""}`,
+		`{"type": "VBANK_SUBSCRIBE", "address": "` + addr4 + `", "denom": "ubld"}`,
+		`{"type": "VBANK_UNSUBSCRIBE", "address": "` + addr4 + `", "denom": "ubld"}`,
+	} {
+		ret, err := ch.Receive(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if ret != "true" {
+			t.Errorf("got %v, want true", ret)
+		}
+	}
+	if _, err := ch.Receive(sdk.WrapSDKContext(ctx), `{"type": "VBANK_SUBSCRIBE", "address": "foo", "denom": "ubld"}`); err == nil {
+		t.Error("expected invalid address error")
+	}
+
+	wantSubscriptions := []types.BalanceSubscription{
+		{Address: addr3, Denom: "urun"},
+		{Address: addr4, Denom: ""},
+	}
+	gotSubscriptions := keeper.GetAllSubscriptions(ctx)
+	sort.Slice(gotSubscriptions, func(i, j int) bool {
+		return gotSubscriptions[i].Address < gotSubscriptions[j].Address
+	})
+	sort.Slice(wantSubscriptions, func(i, j int) bool {
+		return wantSubscriptions[i].Address < wantSubscriptions[j].Address
+	})
+	if !reflect.DeepEqual(gotSubscriptions, wantSubscriptions) {
+		t.Errorf("got subscriptions %v, want %v", gotSubscriptions, wantSubscriptions)
+	}
+
+	events := []abci.Event{
+		{
+			Type: "coin_received",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("receiver"), Value: []byte(addr3)},
+				{Key: []byte("amount"), Value: []byte("500ubld,600urun")},
+			},
+		},
+		{
+			Type: "coin_spent",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("spender"), Value: []byte(addr4)},
+				{Key: []byte("amount"), Value: []byte("500ubld,600ushmoo")},
+			},
+		},
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManagerWithHistory(events))
+	am.EndBlock(ctx, abci.RequestEndBlock{})
+
+	wantMsg := newBalances(
+		account(addr3, coin("urun", "7")),
+		account(addr4, coin("ubld", "2000")),
+		account(addr4, coin("ushmoo", "0")),
+	)
+	if len(msgsSent) != 1 {
+		t.Fatalf("got msgs = %v, want one message", msgsSent)
+	}
+	gotMsg, _, err := decodeBalances([]byte(msgsSent[0]))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	if !reflect.DeepEqual(gotMsg, wantMsg) {
+		t.Errorf("got sent message %v, want %v", gotMsg, wantMsg)
+	}
+}
+
 func Test_EndBlock_Rewards(t *testing.T) {
 	bank := &mockBank{
 		balances: map[string]sdk.Coins{
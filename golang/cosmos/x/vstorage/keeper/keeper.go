@@ -10,8 +10,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	db "github.com/tendermint/tm-db"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -294,6 +296,20 @@ func (k Keeper) GetChildren(ctx sdk.Context, path string) *types.Children {
 	return &children
 }
 
+// PaginateChildren calls onChild with the entry of each child of path (in
+// lexicographic order of the child name) selected by pageReq, returning the
+// corresponding page response.
+func (k Keeper) PaginateChildren(ctx sdk.Context, path string, pageReq *query.PageRequest, onChild func(entry agoric.KVEntry) error) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PathToChildrenPrefix(path))
+	return query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		childPath := string(key)
+		if len(path) > 0 {
+			childPath = path + types.PathSeparator + childPath
+		}
+		return onChild(k.GetEntry(ctx, childPath))
+	})
+}
+
 // HasStorage tells if a given path has data.  Some storage nodes have no data
 // (just an empty string) and exist only to provide linkage to subnodes with
 // data.
@@ -454,16 +470,18 @@ func (k Keeper) GetQueueLength(ctx sdk.Context, queuePath string) (sdk.Int, erro
 	return tail.Sub(head), nil
 }
 
-func (k Keeper) PushQueueItem(ctx sdk.Context, queuePath string, value string) error {
+// PushQueueItem appends a value to the queue at queuePath, returning the
+// index at which it was stored.
+func (k Keeper) PushQueueItem(ctx sdk.Context, queuePath string, value string) (sdk.Int, error) {
 	// Get the current queue tail, defaulting to zero if its vstorage doesn't exist.
 	// The `tail` is the value of the next index to be inserted
 	tail, err := k.getIntValue(ctx, queuePath+".tail")
 	if err != nil {
-		return err
+		return sdk.Int{}, err
 	}
 
 	if tail.Equal(MaxSDKInt) {
-		return errors.New(queuePath + " overflow")
+		return sdk.Int{}, errors.New(queuePath + " overflow")
 	}
 	nextTail := tail.Add(sdk.NewInt(1))
 
@@ -474,5 +492,5 @@ func (k Keeper) PushQueueItem(ctx sdk.Context, queuePath string, value string) e
 	// Update the tail to point to the next available entry.
 	path = queuePath + ".tail"
 	k.SetStorage(ctx, agoric.NewKVEntry(path, nextTail.String()))
-	return nil
+	return tail, nil
 }
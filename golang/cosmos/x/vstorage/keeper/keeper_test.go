@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -292,3 +293,72 @@ func TestStorageNotify(t *testing.T) {
 		t.Errorf("got after second flush events %#v, want %#v", got, expectedAfterFlushEvents)
 	}
 }
+
+func TestQueue(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	for i, value := range []string{"first", "second", "third"} {
+		index, err := keeper.PushQueueItem(ctx, "queue", value)
+		if err != nil {
+			t.Fatalf("push %d: unexpected error %v", i, err)
+		}
+		if !index.Equal(sdk.NewInt(int64(i))) {
+			t.Errorf("push %d: got index %s, want %d", i, index, i)
+		}
+		if got := keeper.GetEntry(ctx, "queue."+index.String()).StringValue(); got != value {
+			t.Errorf("push %d: got stored value %q, want %q", i, got, value)
+		}
+	}
+
+	length, err := keeper.GetQueueLength(ctx, "queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !length.Equal(sdk.NewInt(3)) {
+		t.Errorf("got queue length %s, want 3", length)
+	}
+}
+
+func TestPaginateChildren(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.c", "valueC"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.a", "valueA"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.b.grandchild", "valueG"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("other", "valueO"))
+
+	collect := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		got := []string{}
+		pageRes, err := keeper.PaginateChildren(ctx, "parent", pageReq, func(entry agoric.KVEntry) error {
+			got = append(got, entry.Key()+"="+entry.StringValue())
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		return got, pageRes
+	}
+
+	got, pageRes := collect(&query.PageRequest{Limit: 2, CountTotal: true})
+	if !childrenEqual(got, []string{"parent.a=valueA", "parent.b="}) {
+		t.Errorf("got first page %q, want [parent.a=valueA parent.b=]", got)
+	}
+	if pageRes.Total != 3 {
+		t.Errorf("got total %d, want 3", pageRes.Total)
+	}
+
+	got, pageRes = collect(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	if !childrenEqual(got, []string{"parent.c=valueC"}) {
+		t.Errorf("got second page %q, want [parent.c=valueC]", got)
+	}
+	if pageRes.NextKey != nil {
+		t.Errorf("got next key %q, want nil", pageRes.NextKey)
+	}
+
+	got, _ = collect(nil)
+	if !childrenEqual(got, []string{"parent.a=valueA", "parent.b=", "parent.c=valueC"}) {
+		t.Errorf("got all children %q, want [parent.a=valueA parent.b= parent.c=valueC]", got)
+	}
+}
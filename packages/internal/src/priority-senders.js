@@ -16,9 +16,16 @@ harden(normalizeSenderNamespace);
 /**
  * XXX lets holder manage sender list for all namespaces
  *
+ * The namespaces of an address may also be changed by the chain itself (see
+ * AddHighPrioritySender in golang/cosmos/x/swingset/keeper/keeper.go). When
+ * `readValue` is provided, the namespaces of an address are read back from
+ * vstorage before each change so that such changes are not overwritten.
+ *
  * @param {ERef<import('./lib-chainStorage.js').StorageNode>} sendersNode
+ * @param {(path: string) => ERef<string | null | undefined>} [readValue]
+ *   reads the current vstorage value at a path
  */
-export const makePrioritySendersManager = sendersNode => {
+export const makePrioritySendersManager = (sendersNode, readValue) => {
   /**
    * address to tuple with storage node and set of namespaces that requested priority
    *
@@ -39,9 +46,27 @@ export const makePrioritySendersManager = sendersNode => {
     );
   };
 
+  /**
+   * Update the namespaces of a record from its vstorage value.
+   *
+   * @param {readonly [node: StorageNode, namespaces: Set<string>]} record
+   */
+  const syncRecord = async record => {
+    if (!readValue) {
+      return;
+    }
+    const [node, namespaces] = record;
+    const value = await readValue(await E(node).getPath());
+    namespaces.clear();
+    for (const namespace of value ? value.split(',') : []) {
+      namespaces.add(namespace);
+    }
+  };
+
   const provideRecordForAddress = async address => {
     const extant = addressRecords.get(address);
     if (extant) {
+      await syncRecord(extant);
       return extant;
     }
     const node = await E(sendersNode).makeChildNode(address, {
@@ -50,9 +75,29 @@ export const makePrioritySendersManager = sendersNode => {
     /** @type {readonly [ node: StorageNode, namespaces: Set<string> ]} */
     const r = [node, new Set()];
     addressRecords.set(address, r);
+    await syncRecord(r);
     return r;
   };
 
+  /**
+   * Changes are applied one at a time, so that each reads the namespaces
+   * written by the previous one.
+   */
+  let lastChange = Promise.resolve();
+  /**
+   * @template T
+   * @param {() => Promise<T>} change
+   * @returns {Promise<T>}
+   */
+  const serialize = change => {
+    const result = lastChange.then(change);
+    lastChange = result.then(
+      () => {},
+      () => {},
+    );
+    return result;
+  };
+
   return Far('prioritySenders manager', {
     /**
      * @param {string} rawNamespace
@@ -62,40 +107,51 @@ export const makePrioritySendersManager = sendersNode => {
     add: async (rawNamespace, address) => {
       const namespace = normalizeSenderNamespace(rawNamespace);
 
-      const record = await provideRecordForAddress(address);
+      return serialize(async () => {
+        const record = await provideRecordForAddress(address);
 
-      const [node, namespaces] = record;
-      if (namespaces.has(namespace)) {
-        throw Fail`namespace ${q(namespace)} already has address ${q(address)}`;
-      }
-      namespaces.add(namespace);
+        const [node, namespaces] = record;
+        if (namespaces.has(namespace)) {
+          throw Fail`namespace ${q(namespace)} already has address ${q(
+            address,
+          )}`;
+        }
+        namespaces.add(namespace);
 
-      return refreshVstorage(node, namespaces);
+        return refreshVstorage(node, namespaces);
+      });
     },
     /**
      * @param {string} rawNamespace
      * @param {string} address
      * @returns {Promise<void>}
      */
-    remove: (rawNamespace, address) => {
+    remove: async (rawNamespace, address) => {
       const namespace = normalizeSenderNamespace(rawNamespace);
-      const record = addressRecords.get(address);
-      if (!record) {
-        throw Fail`address not registered: ${q(address)}`;
-      }
-      const [node, namespaces] = record;
-      if (!namespaces.has(namespace)) {
-        throw Fail`namespace ${q(namespace)} does not have address ${q(
-          address,
-        )}`;
-      }
-
-      namespaces.delete(namespace);
-      if (namespaces.size === 0) {
-        addressRecords.delete(address);
-      }
-
-      return refreshVstorage(node, namespaces);
+
+      return serialize(async () => {
+        const record =
+          readValue || addressRecords.has(address)
+            ? await provideRecordForAddress(address)
+            : undefined;
+        if (!record || record[1].size === 0) {
+          addressRecords.delete(address);
+          throw Fail`address not registered: ${q(address)}`;
+        }
+        const [node, namespaces] = record;
+        if (!namespaces.has(namespace)) {
+          throw Fail`namespace ${q(namespace)} does not have address ${q(
+            address,
+          )}`;
+        }
+
+        namespaces.delete(namespace);
+        if (namespaces.size === 0) {
+          addressRecords.delete(address);
+        }
+
+        return refreshVstorage(node, namespaces);
+      });
     },
   });
 };
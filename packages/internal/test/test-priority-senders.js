@@ -45,12 +45,12 @@ test('errors', async t => {
   });
   const manager = makePrioritySendersManager(storage.rootNode);
 
-  t.throws(() => manager.remove('oracles', 'agoric1a'), {
+  await t.throwsAsync(manager.remove('oracles', 'agoric1a'), {
     message: 'address not registered: "agoric1a"',
   });
 
   await manager.add('oracles', 'agoric1a');
-  t.throws(() => manager.remove('unknown', 'agoric1a'), {
+  await t.throwsAsync(manager.remove('unknown', 'agoric1a'), {
     message: 'namespace "unknown" does not have address "agoric1a"',
   });
 
@@ -75,3 +75,41 @@ test('normalization', async t => {
     'something_with_spaces,something_with_spaces_and___,this_has_commas_',
   );
 });
+
+test('changes made by the chain', async t => {
+  const storage = makeFakeStorageKit(HIGH_PRIORITY_SENDERS, {
+    sequence: false,
+  });
+  const manager = makePrioritySendersManager(storage.rootNode, path =>
+    storage.toStorage({ method: 'get', args: [path] }),
+  );
+  const key = (/** @type {string} */ address) =>
+    `${HIGH_PRIORITY_SENDERS}.${address}`;
+
+  // added by the chain only
+  storage.data.set(key('agoric1a'), 'gov');
+  await manager.add('oracles', 'agoric1a');
+  t.is(storage.data.get(key('agoric1a')), 'gov,oracles');
+
+  // removed by the chain after being added by the manager
+  storage.data.set(key('agoric1a'), 'gov');
+  await t.throwsAsync(manager.remove('oracles', 'agoric1a'), {
+    message: 'namespace "oracles" does not have address "agoric1a"',
+  });
+  await manager.remove('gov', 'agoric1a');
+  t.is(storage.data.get(key('agoric1a')), undefined);
+
+  storage.data.set(key('agoric1b'), 'gov');
+  await manager.remove('gov', 'agoric1b');
+  t.is(storage.data.get(key('agoric1b')), undefined);
+  await t.throwsAsync(manager.remove('gov', 'agoric1b'), {
+    message: 'address not registered: "agoric1b"',
+  });
+
+  // concurrent changes of the same address
+  await Promise.all([
+    manager.add('ec', 'agoric1c'),
+    manager.add('oracles', 'agoric1c'),
+  ]);
+  t.is(storage.data.get(key('agoric1c')), 'ec,oracles');
+});
@@ -416,7 +416,9 @@ export const produceHighPrioritySendersManager = async ({
    * updated with the new object, which can be done with an upgrade (regular or
    * null) with the new object in privateArgs.
    */
-  const manager = makePrioritySendersManager(sendersNode);
+  const manager = makePrioritySendersManager(sendersNode, path =>
+    E(storageBridgeManager).toBridge({ method: 'get', args: [path] }),
+  );
 
   managerP.resolve(manager);
 };